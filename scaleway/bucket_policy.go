@@ -0,0 +1,162 @@
+package scaleway
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+)
+
+// bucketPolicyWriteActions are the Object Storage actions modifying a bucket or its objects
+var bucketPolicyWriteActions = []string{
+	"s3:AbortMultipartUpload",
+	"s3:DeleteBucket",
+	"s3:DeleteBucketPolicy",
+	"s3:DeleteBucketTagging",
+	"s3:DeleteBucketWebsite",
+	"s3:DeleteObject",
+	"s3:DeleteObjectTagging",
+	"s3:DeleteObjectVersion",
+	"s3:DeleteObjectVersionTagging",
+	"s3:PutBucketAcl",
+	"s3:PutBucketCORS",
+	"s3:PutBucketObjectLockConfiguration",
+	"s3:PutBucketPolicy",
+	"s3:PutBucketTagging",
+	"s3:PutBucketVersioning",
+	"s3:PutBucketWebsite",
+	"s3:PutLifecycleConfiguration",
+	"s3:PutObject",
+	"s3:PutObjectAcl",
+	"s3:PutObjectLegalHold",
+	"s3:PutObjectRetention",
+	"s3:PutObjectTagging",
+	"s3:PutObjectVersionAcl",
+	"s3:PutObjectVersionTagging",
+	"s3:RestoreObject",
+}
+
+// bucketPolicyDocument is a bucket policy, whose statement may be a single statement or a list
+type bucketPolicyDocument struct {
+	Version   string      `json:"Version"`
+	ID        string      `json:"Id"`
+	Statement interface{} `json:"Statement"`
+}
+
+// bucketPolicyStatement is a statement of a bucket policy, with its principals,
+// actions and resources normalized to lists and its derived flags
+type bucketPolicyStatement struct {
+	Index              int
+	Sid                string
+	Effect             string
+	Principals         []string
+	Actions            []string
+	Resources          []string
+	Conditions         interface{}
+	AllowsAnonymous    bool
+	AllowsCrossProject bool
+	GrantsWrite        bool
+}
+
+// parseBucketPolicy :: returns the normalized statements of the policy of a bucket of the project
+func parseBucketPolicy(policy string, projectID string) (*bucketPolicyDocument, []bucketPolicyStatement, error) {
+	var document bucketPolicyDocument
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, nil, err
+	}
+
+	var rawStatements []interface{}
+	switch value := document.Statement.(type) {
+	case []interface{}:
+		rawStatements = value
+	case map[string]interface{}:
+		rawStatements = []interface{}{value}
+	}
+
+	statements := []bucketPolicyStatement{}
+	for i, rawStatement := range rawStatements {
+		fields, ok := rawStatement.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		statement := bucketPolicyStatement{
+			Index:      i,
+			Principals: normalizeBucketPolicyPrincipal(fields["Principal"]),
+			Actions:    normalizeBucketPolicyValues(fields["Action"]),
+			Resources:  normalizeBucketPolicyValues(fields["Resource"]),
+			Conditions: fields["Condition"],
+		}
+		statement.Sid, _ = fields["Sid"].(string)
+		statement.Effect, _ = fields["Effect"].(string)
+
+		// Only the allowed statements grant access
+		if strings.EqualFold(statement.Effect, "Allow") {
+			for _, principal := range statement.Principals {
+				if principal == "*" {
+					statement.AllowsAnonymous = true
+				}
+				if id, found := strings.CutPrefix(principal, "project_id:"); found && id != projectID {
+					statement.AllowsCrossProject = true
+				}
+			}
+			for _, action := range statement.Actions {
+				if isBucketPolicyWriteAction(action) {
+					statement.GrantsWrite = true
+				}
+			}
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return &document, statements, nil
+}
+
+// normalizeBucketPolicyPrincipal :: returns the principals of a statement, either "*"
+// or e.g. {"SCW": ["project_id:<id>", "application_id:<id>"]}, as a list
+func normalizeBucketPolicyPrincipal(principal interface{}) []string {
+	fields, ok := principal.(map[string]interface{})
+	if !ok {
+		return normalizeBucketPolicyValues(principal)
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	principals := []string{}
+	for _, key := range keys {
+		principals = append(principals, normalizeBucketPolicyValues(fields[key])...)
+	}
+	return principals
+}
+
+// normalizeBucketPolicyValues :: returns a policy value, a string or a list of strings, as a list
+func normalizeBucketPolicyValues(value interface{}) []string {
+	values := []string{}
+	switch value := value.(type) {
+	case string:
+		values = append(values, value)
+	case []interface{}:
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
+// isBucketPolicyWriteAction :: returns whether the action, which may have wildcards, matches a write action
+func isBucketPolicyWriteAction(action string) bool {
+	pattern := strings.ToLower(action)
+	for _, writeAction := range bucketPolicyWriteActions {
+		if matched, _ := path.Match(pattern, strings.ToLower(writeAction)); matched {
+			return true
+		}
+	}
+	return false
+}
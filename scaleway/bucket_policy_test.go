@@ -0,0 +1,57 @@
+package scaleway
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBucketPolicy(t *testing.T) {
+	// A single statement isn't wrapped in a list
+	policy := `{
+		"Version": "2023-04-17",
+		"Statement": {
+			"Effect": "Allow",
+			"Principal": {"SCW": "project_id:4c7b2e1a-8d3f-4a6b-9e5c-0f1a2b3c4d5e"},
+			"Action": ["s3:List*", "s3:Get*"],
+			"Resource": "acme-assets"
+		}
+	}`
+
+	document, statements, err := parseBucketPolicy(policy, "4c7b2e1a-8d3f-4a6b-9e5c-0f1a2b3c4d5e")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if document.Version != "2023-04-17" || len(statements) != 1 {
+		t.Fatalf("unexpected policy: %+v, %+v", document, statements)
+	}
+	if !reflect.DeepEqual(statements[0].Resources, []string{"acme-assets"}) {
+		t.Errorf("unexpected resources: %v", statements[0].Resources)
+	}
+	// The project of the bucket, and read actions only
+	if statements[0].AllowsAnonymous || statements[0].AllowsCrossProject || statements[0].GrantsWrite {
+		t.Errorf("unexpected flags: %+v", statements[0])
+	}
+
+	if _, _, err := parseBucketPolicy("Version: 2023-04-17", ""); err == nil {
+		t.Error("expected an error parsing an invalid policy")
+	}
+}
+
+func TestIsBucketPolicyWriteAction(t *testing.T) {
+	tests := map[string]bool{
+		"*":                  true,
+		"s3:*":               true,
+		"s3:Put*":            true,
+		"s3:*Object":         true,
+		"s3:deleteobject":    true,
+		"s3:GetObject":       false,
+		"s3:List*":           false,
+		"s3:Get*":            false,
+		"s3:GetBucketPolicy": false,
+	}
+	for action, want := range tests {
+		if got := isBucketPolicyWriteAction(action); got != want {
+			t.Errorf("isBucketPolicyWriteAction(%q) = %t, want %t", action, got, want)
+		}
+	}
+}
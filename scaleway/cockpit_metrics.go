@@ -0,0 +1,112 @@
+package scaleway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	cockpit "github.com/scaleway/scaleway-sdk-go/api/cockpit/v1beta1"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// cockpitMetricsClient queries the Prometheus-compatible API of the Cockpit metrics
+type cockpitMetricsClient struct {
+	httpClient *http.Client
+	token      string
+}
+
+// cockpitMetricSeries is a time series of the result of a range query, its
+// values are pairs of a Unix timestamp and a sample value formatted as a string
+type cockpitMetricSeries struct {
+	Metric map[string]string `json:"metric"`
+	Values [][2]interface{}  `json:"values"`
+}
+
+// cockpitQueryResponse is the response of the Prometheus query API
+type cockpitQueryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string                `json:"resultType"`
+		Result     []cockpitMetricSeries `json:"result"`
+	} `json:"data"`
+}
+
+// queryRange :: evaluates the PromQL query over a range of time, one sample every step
+func (c *cockpitMetricsClient) queryRange(ctx context.Context, metricsURL string, query string, start time.Time, end time.Time, step time.Duration) ([]cockpitMetricSeries, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+	params.Set("step", strconv.FormatInt(int64(step/time.Second), 10))
+
+	endpoint := strings.TrimSuffix(metricsURL, "/") + "/prometheus/api/v1/query_range?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Token", c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result cockpitQueryResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("unable to parse the Cockpit metrics response (HTTP %d): %v", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || result.Status != "success" {
+		return nil, fmt.Errorf("Cockpit metrics query failed (HTTP %d): %s: %s", resp.StatusCode, result.ErrorType, result.Error)
+	}
+	if result.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("unexpected Cockpit metrics result type %q", result.Data.ResultType)
+	}
+
+	return result.Data.Result, nil
+}
+
+// getCockpitMetricsURL :: returns the URL of the metrics of the Cockpit of a project,
+// or an empty string if the Cockpit of the project isn't activated
+func getCockpitMetricsURL(ctx context.Context, d *plugin.QueryData, projectID string) (string, error) {
+	cacheKey := "scaleway.cockpit-" + projectID
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	cockpitApi := cockpit.NewAPI(client)
+
+	var metricsURL string
+	resp, err := cockpitApi.GetCockpit(&cockpit.GetCockpitRequest{
+		ProjectID: projectID,
+	})
+	if err != nil && !is404Error(err) {
+		return "", err
+	}
+	if err == nil && resp.Endpoints != nil {
+		metricsURL = resp.Endpoints.MetricsURL
+	}
+
+	// set cache
+	d.ConnectionManager.Cache.Set(cacheKey, metricsURL)
+
+	return metricsURL, nil
+}
@@ -1,14 +1,27 @@
 package scaleway
 
 import (
+	"fmt"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
 type scalewayConfig struct {
-	AccessKey      *string  `hcl:"access_key"`
-	SecretKey      *string  `hcl:"secret_key"`
-	OrganizationID *string  `hcl:"organization_id"`
-	Regions        []string `hcl:"regions,optional"`
+	AccessKey       *string            `hcl:"access_key"`
+	SecretKey       *string            `hcl:"secret_key"`
+	OrganizationID  *string            `hcl:"organization_id"`
+	OrganizationIDs []string           `hcl:"organization_ids,optional"`
+	Regions         []string           `hcl:"regions,optional"`
+	Zones           []string           `hcl:"zones,optional"`
+	Projects        []string           `hcl:"projects,optional"`
+	Profile         *string            `hcl:"profile"`
+	ConfigFile      *string            `hcl:"config_file"`
+	APIURL          *string            `hcl:"api_url"`
+	S3Endpoint      *string            `hcl:"s3_endpoint"`
+	CockpitToken    *string            `hcl:"cockpit_token"`
+	MaxRetries      *int               `hcl:"max_retries"`
+	MinRetryDelay   *int               `hcl:"min_retry_delay"`
+	RateLimits      map[string]float64 `hcl:"rate_limits,optional"`
 }
 
 func ConfigInstance() interface{} {
@@ -23,3 +36,31 @@ func GetConfig(connection *plugin.Connection) scalewayConfig {
 	config, _ := connection.Config.(scalewayConfig)
 	return config
 }
+
+// validateConnectionConfig :: returns an error describing the invalid arguments of the connection config
+func validateConnectionConfig(config scalewayConfig) error {
+	if invalidPatterns := getInvalidRegionPatterns(config.Regions); len(invalidPatterns) > 0 {
+		validRegions := make([]string, len(Regions()))
+		for i, region := range Regions() {
+			validRegions[i] = region.String()
+		}
+		return &InvalidRegionsError{Patterns: invalidPatterns, ValidRegions: validRegions}
+	}
+	if invalidPatterns := getInvalidZonePatterns(config.Zones); len(invalidPatterns) > 0 {
+		validZones := make([]string, len(Zones()))
+		for i, zone := range Zones() {
+			validZones[i] = zone.String()
+		}
+		return &InvalidZonesError{Patterns: invalidPatterns, ValidZones: validZones}
+	}
+	if config.MaxRetries != nil && *config.MaxRetries < 0 {
+		return fmt.Errorf("connection config has invalid max_retries: %d. It must be 0 or more", *config.MaxRetries)
+	}
+	if config.MinRetryDelay != nil && *config.MinRetryDelay < 0 {
+		return fmt.Errorf("connection config has invalid min_retry_delay: %d. It must be 0 or more milliseconds", *config.MinRetryDelay)
+	}
+	if invalidRateLimits := getInvalidRateLimits(config.RateLimits); len(invalidRateLimits) > 0 {
+		return &InvalidRateLimitsError{Names: invalidRateLimits}
+	}
+	return nil
+}
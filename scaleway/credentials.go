@@ -0,0 +1,223 @@
+package scaleway
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// credentialSource identifies where a pair of access_key/secret_key was found
+type credentialSource string
+
+const (
+	credentialSourceConfig  credentialSource = "connection config"
+	credentialSourceProfile credentialSource = "Scaleway config profile"
+	credentialSourceEnv     credentialSource = "environment variables"
+)
+
+// scalewayCredentials is the access key and secret key used by both the
+// Scaleway API client and the Object Storage (S3) client
+type scalewayCredentials struct {
+	AccessKey string
+	SecretKey string
+	Source    credentialSource
+}
+
+// PartialCredentialsError is returned when a credential source only defines
+// one of access_key and secret_key
+type PartialCredentialsError struct {
+	Source  credentialSource
+	Missing string
+}
+
+func (e *PartialCredentialsError) Error() string {
+	return fmt.Sprintf("partial credentials found in %s, missing: %s", e.Source, e.Missing)
+}
+
+// MissingCredentialsError is returned when no credential source defines an
+// access_key and a secret_key
+type MissingCredentialsError struct{}
+
+func (e *MissingCredentialsError) Error() string {
+	return "both access_key and secret_key must be configured"
+}
+
+// getCredentials :: returns the credentials resolved for the connection
+func getCredentials(d *plugin.QueryData) (*scalewayCredentials, error) {
+	// Load credentials from cache
+	credentialsCacheKey := "scaleway.credentials"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(credentialsCacheKey); ok {
+		return cachedData.(*scalewayCredentials), nil
+	}
+
+	fileProfile, err := getConfigFileProfile(d)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := resolveCredentials(GetConfig(d.Connection), fileProfile, scw.LoadEnvProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	// save credentials in cache
+	d.ConnectionManager.Cache.Set(credentialsCacheKey, creds)
+
+	return creds, nil
+}
+
+// resolveCredentials :: returns the credentials of the first source defining
+// an access_key or a secret_key, in the following order:
+//  1. the connection config
+//  2. the Scaleway config file profile
+//  3. the environment variables
+//
+// An incomplete source is never completed from a lower priority one.
+func resolveCredentials(config scalewayConfig, fileProfile *scw.Profile, envProfile *scw.Profile) (*scalewayCredentials, error) {
+	type candidate struct {
+		source    credentialSource
+		accessKey *string
+		secretKey *string
+	}
+
+	candidates := []candidate{
+		{credentialSourceConfig, config.AccessKey, config.SecretKey},
+	}
+	if fileProfile != nil {
+		candidates = append(candidates, candidate{credentialSourceProfile, fileProfile.AccessKey, fileProfile.SecretKey})
+	}
+	if envProfile != nil {
+		candidates = append(candidates, candidate{credentialSourceEnv, envProfile.AccessKey, envProfile.SecretKey})
+	}
+
+	for _, c := range candidates {
+		hasAccessKey := c.accessKey != nil && *c.accessKey != ""
+		hasSecretKey := c.secretKey != nil && *c.secretKey != ""
+
+		switch {
+		case hasAccessKey && hasSecretKey:
+			return &scalewayCredentials{
+				AccessKey: *c.accessKey,
+				SecretKey: *c.secretKey,
+				Source:    c.source,
+			}, nil
+		case hasAccessKey:
+			return nil, &PartialCredentialsError{Source: c.source, Missing: "secret_key"}
+		case hasSecretKey:
+			return nil, &PartialCredentialsError{Source: c.source, Missing: "access_key"}
+		}
+	}
+
+	return nil, &MissingCredentialsError{}
+}
+
+// getScalewayProfile :: returns the Scaleway profile resolved from the
+// environment and, if configured, the Scaleway CLI config file. Values
+// defined in the config file profile override the environment variables.
+func getScalewayProfile(d *plugin.QueryData) (*scw.Profile, error) {
+	// Load profile from cache
+	profileCacheKey := "scaleway.profile"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(profileCacheKey); ok {
+		return cachedData.(*scw.Profile), nil
+	}
+
+	// Load defaults from environment variables
+	profile := scw.LoadEnvProfile()
+
+	fileProfile, err := getConfigFileProfile(d)
+	if err != nil {
+		return nil, err
+	}
+	if fileProfile != nil {
+		profile = scw.MergeProfiles(profile, fileProfile)
+	}
+
+	// save profile in cache
+	d.ConnectionManager.Cache.Set(profileCacheKey, profile)
+
+	return profile, nil
+}
+
+// getConfigFileProfile :: returns the Scaleway CLI config file profile of the connection,
+// shared by the credentials and the profile so the config file is only read once
+func getConfigFileProfile(d *plugin.QueryData) (*scw.Profile, error) {
+	// Load profile from cache
+	profileCacheKey := "scaleway.configfileprofile"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(profileCacheKey); ok {
+		return cachedData.(*scw.Profile), nil
+	}
+
+	profile, err := loadConfigFileProfile(GetConfig(d.Connection))
+	if err != nil {
+		return nil, err
+	}
+
+	// save profile in cache
+	d.ConnectionManager.Cache.Set(profileCacheKey, profile)
+
+	return profile, nil
+}
+
+// loadConfigFileProfile :: returns the profile read from the Scaleway CLI
+// config file. The config file is only read if a profile or a config file is
+// set in the connection config, otherwise nil is returned.
+func loadConfigFileProfile(scalewayConfig scalewayConfig) (*scw.Profile, error) {
+	if scalewayConfig.Profile == nil && scalewayConfig.ConfigFile == nil {
+		return nil, nil
+	}
+
+	var config *scw.Config
+	var err error
+
+	if scalewayConfig.ConfigFile != nil {
+		config, err = scw.LoadConfigFromPath(expandHomeDir(*scalewayConfig.ConfigFile))
+	} else {
+		config, err = scw.LoadConfig()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Scaleway config file: %v", err)
+	}
+
+	var profile *scw.Profile
+	if scalewayConfig.Profile != nil {
+		profile, err = config.GetProfile(*scalewayConfig.Profile)
+	} else {
+		profile, err = config.GetActiveProfile()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Scaleway config profile: %v", err)
+	}
+
+	return profile, nil
+}
+
+// getOrganizationID :: returns the organization ID set in the connection config,
+// or the default organization ID of the Scaleway profile
+func getOrganizationID(d *plugin.QueryData) *string {
+	scalewayConfig := GetConfig(d.Connection)
+	if scalewayConfig.OrganizationID != nil {
+		return scalewayConfig.OrganizationID
+	}
+
+	profile, err := getScalewayProfile(d)
+	if err != nil {
+		return nil
+	}
+	return profile.DefaultOrganizationID
+}
+
+// expandHomeDir :: replaces a leading ~ in the given path with the user home directory
+func expandHomeDir(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
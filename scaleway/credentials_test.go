@@ -0,0 +1,137 @@
+package scaleway
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestResolveCredentials(t *testing.T) {
+	configKeys := scalewayConfig{AccessKey: scw.StringPtr("SCWCONFIG"), SecretKey: scw.StringPtr("config-secret")}
+	profileKeys := &scw.Profile{AccessKey: scw.StringPtr("SCWPROFILE"), SecretKey: scw.StringPtr("profile-secret")}
+	envKeys := &scw.Profile{AccessKey: scw.StringPtr("SCWENV"), SecretKey: scw.StringPtr("env-secret")}
+
+	tests := []struct {
+		name          string
+		config        scalewayConfig
+		fileProfile   *scw.Profile
+		envProfile    *scw.Profile
+		wantAccessKey string
+		wantSource    credentialSource
+		wantPartial   *PartialCredentialsError
+		wantMissing   bool
+	}{
+		{
+			name:          "connection config overrides profile and environment",
+			config:        configKeys,
+			fileProfile:   profileKeys,
+			envProfile:    envKeys,
+			wantAccessKey: "SCWCONFIG",
+			wantSource:    credentialSourceConfig,
+		},
+		{
+			name:          "profile overrides environment",
+			fileProfile:   profileKeys,
+			envProfile:    envKeys,
+			wantAccessKey: "SCWPROFILE",
+			wantSource:    credentialSourceProfile,
+		},
+		{
+			name:          "environment only",
+			envProfile:    envKeys,
+			wantAccessKey: "SCWENV",
+			wantSource:    credentialSourceEnv,
+		},
+		{
+			name:        "partial connection config is not completed from the environment",
+			config:      scalewayConfig{AccessKey: scw.StringPtr("SCWCONFIG")},
+			envProfile:  envKeys,
+			wantPartial: &PartialCredentialsError{Source: credentialSourceConfig, Missing: "secret_key"},
+		},
+		{
+			name:        "partial profile",
+			fileProfile: &scw.Profile{SecretKey: scw.StringPtr("profile-secret")},
+			envProfile:  envKeys,
+			wantPartial: &PartialCredentialsError{Source: credentialSourceProfile, Missing: "access_key"},
+		},
+		{
+			name:        "partial environment",
+			envProfile:  &scw.Profile{AccessKey: scw.StringPtr("SCWENV")},
+			wantPartial: &PartialCredentialsError{Source: credentialSourceEnv, Missing: "secret_key"},
+		},
+		{
+			name:        "no credentials",
+			envProfile:  &scw.Profile{},
+			wantMissing: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := resolveCredentials(tt.config, tt.fileProfile, tt.envProfile)
+
+			switch {
+			case tt.wantPartial != nil:
+				var partialErr *PartialCredentialsError
+				if !errors.As(err, &partialErr) {
+					t.Fatalf("expected PartialCredentialsError, got %v", err)
+				}
+				if *partialErr != *tt.wantPartial {
+					t.Errorf("expected %v, got %v", tt.wantPartial, partialErr)
+				}
+			case tt.wantMissing:
+				var missingErr *MissingCredentialsError
+				if !errors.As(err, &missingErr) {
+					t.Fatalf("expected MissingCredentialsError, got %v", err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if creds.AccessKey != tt.wantAccessKey || creds.Source != tt.wantSource {
+					t.Errorf("expected %s from %s, got %s from %s", tt.wantAccessKey, tt.wantSource, creds.AccessKey, creds.Source)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadConfigFileProfile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	content := `access_key: SCWDEFAULT
+secret_key: default-secret
+default_region: fr-par
+profiles:
+  prod:
+    access_key: SCWPROD
+    secret_key: prod-secret
+    default_organization_id: 11111111-1111-1111-1111-111111111111
+`
+	if err := os.WriteFile(configFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := loadConfigFileProfile(scalewayConfig{ConfigFile: &configFile, Profile: scw.StringPtr("prod")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *profile.AccessKey != "SCWPROD" || *profile.DefaultOrganizationID != "11111111-1111-1111-1111-111111111111" {
+		t.Errorf("unexpected profile: %s", profile)
+	}
+	// Values missing from the named profile are inherited from the default profile
+	if profile.DefaultRegion == nil || *profile.DefaultRegion != "fr-par" {
+		t.Errorf("expected default_region fr-par to be inherited, got %v", profile.DefaultRegion)
+	}
+
+	if _, err := loadConfigFileProfile(scalewayConfig{ConfigFile: &configFile, Profile: scw.StringPtr("unknown")}); err == nil {
+		t.Error("expected an error for an unknown profile")
+	}
+
+	profile, err = loadConfigFileProfile(scalewayConfig{})
+	if err != nil || profile != nil {
+		t.Errorf("expected no profile when neither profile nor config_file is set, got %v, %v", profile, err)
+	}
+}
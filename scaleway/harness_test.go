@@ -0,0 +1,430 @@
+package scaleway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The test harness runs queries through the plugin server, as Steampipe does,
+// against a local HTTP server replaying recorded Scaleway API and Object
+// Storage responses from testdata/<fixture>.json.
+
+const (
+	testConnectionName = "scaleway_test"
+	testOrganizationID = "4f4c1a2e-3b6f-4c47-9b3e-8d5b2f1a6c70"
+	testProjectID      = "9a1e6e4c-2f0b-4a9d-8c4e-3b7d5f2a1c80"
+)
+
+var (
+	testPluginOnce   sync.Once
+	testPluginServer grpcPluginServer
+	testCounter      int64
+)
+
+// grpcPluginServer is the subset of the plugin server used by the tests
+type grpcPluginServer interface {
+	SetAllConnectionConfigs(req *proto.SetAllConnectionConfigsRequest) (*proto.SetConnectionConfigResponse, error)
+	UpdateConnectionConfigs(req *proto.UpdateConnectionConfigsRequest) (*proto.UpdateConnectionConfigsResponse, error)
+	Execute(req *proto.ExecuteRequest, stream proto.WrapperPlugin_ExecuteServer) error
+}
+
+// fixtureInteraction is a recorded HTTP request and its response
+type fixtureInteraction struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Query   map[string]string `json:"query"`
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	// Body is written as is when it is a JSON string (e.g. S3 XML responses),
+	// and marshalled otherwise
+	Body json.RawMessage `json:"body"`
+}
+
+// fixtureServer replays recorded interactions and records the requests it receives
+type fixtureServer struct {
+	*httptest.Server
+	t            *testing.T
+	interactions []fixtureInteraction
+
+	mu       sync.Mutex
+	requests []string
+}
+
+// newFixtureServer starts a server replaying testdata/<name>.json
+func newFixtureServer(t *testing.T, name string) *fixtureServer {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatalf("unable to read fixture %s: %v", name, err)
+	}
+
+	s := &fixtureServer{t: t}
+	if err := json.Unmarshal(data, &s.interactions); err != nil {
+		t.Fatalf("unable to parse fixture %s: %v", name, err)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// match returns the interaction with the same method and path whose query
+// parameters are all set on the request, preferring the most specific one
+func (s *fixtureServer) match(r *http.Request) *fixtureInteraction {
+	var found *fixtureInteraction
+	query := r.URL.Query()
+	for i := range s.interactions {
+		interaction := &s.interactions[i]
+		if interaction.Method != r.Method || interaction.Path != r.URL.Path {
+			continue
+		}
+		matched := true
+		for key, value := range interaction.Query {
+			if !query.Has(key) || query.Get(key) != value {
+				matched = false
+				break
+			}
+		}
+		if matched && (found == nil || len(interaction.Query) > len(found.Query)) {
+			found = interaction
+		}
+	}
+	return found
+}
+
+func (s *fixtureServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+	s.mu.Unlock()
+
+	interaction := s.match(r)
+	if interaction == nil {
+		s.t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotImplemented)
+		_, _ = io.WriteString(w, `{"message":"no recorded response"}`)
+		return
+	}
+
+	body := []byte(interaction.Body)
+	var raw string
+	if err := json.Unmarshal(interaction.Body, &raw); err == nil {
+		body = []byte(raw)
+	}
+	// Recorded URLs pointing to the fixture server itself, e.g. the Cockpit metrics URL
+	body = bytes.ReplaceAll(body, []byte("{{server_url}}"), []byte(s.URL))
+
+	if _, ok := interaction.Headers["Content-Type"]; !ok {
+		if strings.HasPrefix(strings.TrimSpace(string(body)), "<") {
+			w.Header().Set("Content-Type", "application/xml")
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+		}
+	}
+	for key, value := range interaction.Headers {
+		w.Header().Set(key, value)
+	}
+
+	status := interaction.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// requestCount returns the number of requests received for the path. The
+// path may have query parameters, which must all be set on the requests.
+func (s *fixtureServer) requestCount(method, path string) int {
+	path, rawQuery, _ := strings.Cut(path, "?")
+	want, _ := url.ParseQuery(rawQuery)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, request := range s.requests {
+		requestPath, requestQuery, _ := strings.Cut(strings.TrimPrefix(request, method+" "), "?")
+		if !strings.HasPrefix(request, method+" ") || requestPath != path {
+			continue
+		}
+		query, _ := url.ParseQuery(requestQuery)
+		matched := true
+		for key := range want {
+			if !query.Has(key) || query.Get(key) != want.Get(key) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			count++
+		}
+	}
+	return count
+}
+
+// testPlugin returns the plugin server shared by the tests
+func testPlugin(t *testing.T) grpcPluginServer {
+	t.Helper()
+	testPluginOnce.Do(func() {
+		testPluginServer = plugin.Server(&plugin.ServeOpts{PluginFunc: Plugin})
+	})
+	return testPluginServer
+}
+
+// testConnectionConfig returns a connection config targeting the fixture server
+func testConnectionConfig(server *fixtureServer, extra string) string {
+	return fmt.Sprintf(`
+access_key      = "SCWTESTTESTTESTTESTT"
+secret_key      = "5b0d8f3e-1c2a-4e6b-9f7d-3a8c2e1b4d60"
+organization_id = "%s"
+regions         = ["fr-par"]
+zones           = ["fr-par-1"]
+api_url         = "%s"
+s3_endpoint     = "%s"
+max_retries     = 0
+%s`, testOrganizationID, server.URL, server.URL, extra)
+}
+
+// newTestConnection adds a connection to the plugin and returns its name. Each
+// test uses its own connection, so clients and matrices are not shared.
+func newTestConnection(t *testing.T, config string) string {
+	t.Helper()
+
+	connectionName, failure := loadTestConnection(t, config)
+	if failure != "" {
+		t.Fatalf("connection failed: %s", failure)
+	}
+	return connectionName
+}
+
+// loadTestConnection adds a connection to the plugin and returns its name and
+// the error message of the plugin if the connection failed to load
+func loadTestConnection(t *testing.T, config string) (string, string) {
+	t.Helper()
+
+	connectionName := fmt.Sprintf("%s_%d", testConnectionName, atomic.AddInt64(&testCounter, 1))
+	p := testPlugin(t)
+	res, err := p.SetAllConnectionConfigs(&proto.SetAllConnectionConfigsRequest{
+		Configs: []*proto.ConnectionConfig{{
+			Connection: connectionName,
+			Plugin:     pluginName,
+			Config:     config,
+		}},
+		MaxCacheSizeMb: 16,
+	})
+	// The error combines the connection failures, if any
+	failure, failed := res.GetFailedConnections()[connectionName]
+	if err != nil && !failed {
+		t.Fatalf("SetAllConnectionConfigs failed: %v", err)
+	}
+	return connectionName, failure
+}
+
+// executeStream collects the rows streamed by the plugin
+type executeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	mu   sync.Mutex
+	rows []map[string]interface{}
+}
+
+func (s *executeStream) Context() context.Context { return s.ctx }
+
+func (s *executeStream) Send(res *proto.ExecuteResponse) error {
+	if res == nil || res.Row == nil {
+		return nil
+	}
+	row := map[string]interface{}{}
+	for name, column := range res.Row.Columns {
+		row[name] = columnValue(column)
+	}
+	s.mu.Lock()
+	s.rows = append(s.rows, row)
+	s.mu.Unlock()
+	return nil
+}
+
+// columnValue converts a streamed column to a Go value, JSON columns are unmarshalled
+func columnValue(column *proto.Column) interface{} {
+	switch v := column.Value.(type) {
+	case *proto.Column_NullValue:
+		return nil
+	case *proto.Column_StringValue:
+		return v.StringValue
+	case *proto.Column_BoolValue:
+		return v.BoolValue
+	case *proto.Column_IntValue:
+		return v.IntValue
+	case *proto.Column_DoubleValue:
+		return v.DoubleValue
+	case *proto.Column_IpAddrValue:
+		return v.IpAddrValue
+	case *proto.Column_CidrRangeValue:
+		return v.CidrRangeValue
+	case *proto.Column_LtreeValue:
+		return v.LtreeValue
+	case *proto.Column_TimestampValue:
+		return v.TimestampValue.AsTime()
+	case *proto.Column_JsonValue:
+		var value interface{}
+		if err := json.Unmarshal(v.JsonValue, &value); err != nil {
+			return string(v.JsonValue)
+		}
+		return value
+	}
+	return nil
+}
+
+// testQuery describes a query to run against a table
+type testQuery struct {
+	Connection string
+	Table      string
+	Columns    []string
+	// Quals are equality quals by column name, or quals with another
+	// operator by "<column> <operator>", e.g. "tags ?" or "name in"
+	Quals map[string]string
+	Limit int64
+}
+
+// executeQuery runs the query against the test connection and returns the rows sorted by title/name/id
+func executeQuery(t *testing.T, q testQuery) ([]map[string]interface{}, error) {
+	t.Helper()
+
+	columns := q.Columns
+	if len(columns) == 0 {
+		columns = tableColumnNames(t, q.Table)
+	}
+
+	quals := map[string]*proto.Quals{}
+	for key, value := range q.Quals {
+		name, operator, found := strings.Cut(key, " ")
+		if !found {
+			operator = "="
+		}
+		if quals[name] == nil {
+			quals[name] = &proto.Quals{}
+		}
+		// An "in" qual is sent as an "=" qual with the list of the comma separated values
+		var v *proto.QualValue
+		if operator == "in" {
+			operator = "="
+			list := &proto.QualValueList{}
+			for _, item := range strings.Split(value, ",") {
+				list.Values = append(list.Values, qualValue(t, q.Table, name, item))
+			}
+			v = &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: list}}
+		} else {
+			v = qualValue(t, q.Table, name, value)
+		}
+		quals[name].Quals = append(quals[name].Quals, &proto.Qual{
+			FieldName: name,
+			Operator:  &proto.Qual_StringValue{StringValue: operator},
+			Value:     v,
+		})
+	}
+
+	var limit *proto.NullableInt
+	if q.Limit > 0 {
+		limit = &proto.NullableInt{Value: q.Limit}
+	}
+
+	stream := &executeStream{ctx: context.Background()}
+	err := testPlugin(t).Execute(&proto.ExecuteRequest{
+		Table:        q.Table,
+		QueryContext: &proto.QueryContext{Columns: columns, Quals: quals, Limit: limit},
+		Connection:   q.Connection,
+		CallId:       fmt.Sprintf("test-%d", atomic.AddInt64(&testCounter, 1)),
+		ExecuteConnectionData: map[string]*proto.ExecuteConnectionData{
+			q.Connection: {Limit: limit},
+		},
+	}, stream)
+
+	sort.SliceStable(stream.rows, func(i, j int) bool {
+		return rowKey(stream.rows[i]) < rowKey(stream.rows[j])
+	})
+	return stream.rows, err
+}
+
+func rowKey(row map[string]interface{}) string {
+	for _, column := range []string{"id", "name", "access_key", "title"} {
+		if value, ok := row[column].(string); ok && value != "" {
+			return value
+		}
+	}
+	return fmt.Sprint(row)
+}
+
+// tableColumnNames returns all the columns of a table, so every hydrate function is called
+func tableColumnNames(t *testing.T, tableName string) []string {
+	t.Helper()
+
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	table, ok := tableDefinitions(ctx)[tableName]
+	if !ok {
+		t.Fatalf("unknown table %s", tableName)
+	}
+	names := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		names[i] = column.Name
+	}
+	return names
+}
+
+// qualValue converts the value of a qual to the type of the column
+func qualValue(t *testing.T, tableName, columnName, value string) *proto.QualValue {
+	t.Helper()
+
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	for _, column := range tableDefinitions(ctx)[tableName].Columns {
+		if column.Name != columnName {
+			continue
+		}
+		switch column.Type {
+		case proto.ColumnType_BOOL:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				t.Fatalf("invalid %s qual %q: %v", columnName, value, err)
+			}
+			return &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: b}}
+		case proto.ColumnType_IPADDR, proto.ColumnType_INET:
+			return &proto.QualValue{Value: &proto.QualValue_InetValue{InetValue: &proto.Inet{Addr: value}}}
+		case proto.ColumnType_INT:
+			i, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				t.Fatalf("invalid %s qual %q: %v", columnName, value, err)
+			}
+			return &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: i}}
+		case proto.ColumnType_TIMESTAMP:
+			ts, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				t.Fatalf("invalid %s qual %q: %v", columnName, value, err)
+			}
+			return &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(ts)}}
+		}
+	}
+	return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: value}}
+}
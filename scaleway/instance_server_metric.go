@@ -0,0 +1,417 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	// Default length of the periods the metrics are aggregated over, in seconds
+	instanceServerMetricDefaultPeriod = 300
+	// Number of periods queried when the timestamp isn't restricted
+	instanceServerMetricDefaultPeriods = 1440
+	// Scaleway keeps the metrics of its products in Cockpit for 31 days
+	instanceServerMetricRetention = 31 * 24 * time.Hour
+	// Maximum number of samples per time series of a range query
+	instanceServerMetricMaxSamples = 10000
+	// The Instance metrics are scraped every minute, the rates must cover at least two samples
+	instanceServerMetricMinRateWindow = 2 * time.Minute
+)
+
+// instanceServerMetric is a metric of the instance servers computed from the
+// Cockpit metrics of the Instance product
+type instanceServerMetric struct {
+	Name string
+	Unit string
+	// Query is the PromQL expression of the metric by resource_id, given the
+	// label selector of the servers and the rate window
+	Query string
+}
+
+type instanceServerMetricInfo = struct {
+	ServerID     string
+	ServerName   string
+	MetricName   string
+	Unit         string
+	Timestamp    time.Time
+	Period       int64
+	Average      float64
+	Maximum      float64
+	Minimum      float64
+	SampleCount  int64
+	Zone         scw.Zone
+	Project      string
+	Organization string
+}
+
+// instanceServerMetricKeyColumns :: returns the key columns of the instance server metric tables
+func instanceServerMetricKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:    "server_id",
+			Require: plugin.Optional,
+		},
+		{
+			Name:      "timestamp",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "<", "<=", "="},
+		},
+		{
+			Name:    "period",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "zone",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "project",
+			Require: plugin.Optional,
+		},
+	}
+}
+
+// instanceServerMetricColumns :: returns the columns of the instance server metric tables
+func instanceServerMetricColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "server_id",
+			Description: "The ID of the server.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("ServerID"),
+		},
+		{
+			Name:        "server_name",
+			Description: "The name of the server.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "metric_name",
+			Description: "The name of the metric.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "unit",
+			Description: "The unit of the metric.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "timestamp",
+			Description: "The start of the period the metric is aggregated over.",
+			Type:        proto.ColumnType_TIMESTAMP,
+		},
+		{
+			Name:        "period",
+			Description: "The length of the period the metric is aggregated over, in seconds. Defaults to 300, must be a multiple of 60.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Period"),
+		},
+		{
+			Name:        "average",
+			Description: "The average of the samples of the metric during the period.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Average"),
+		},
+		{
+			Name:        "maximum",
+			Description: "The maximum of the samples of the metric during the period.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Maximum"),
+		},
+		{
+			Name:        "minimum",
+			Description: "The minimum of the samples of the metric during the period.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Minimum"),
+		},
+		{
+			Name:        "sample_count",
+			Description: "The number of samples of the metric during the period.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("SampleCount"),
+		},
+
+		// Scaleway standard columns
+		{
+			Name:        "zone",
+			Description: "Specifies the zone where the server resides.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Zone").Transform(transform.ToString),
+		},
+		{
+			Name:        "project",
+			Description: "The ID of the project where the server resides.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "organization",
+			Description: "The ID of the organization where the server resides.",
+			Type:        proto.ColumnType_STRING,
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+// listInstanceServerMetrics :: returns the list function of an instance server metric table.
+// The servers of the zone are listed, then the metrics of the servers of each project
+// are queried from the Cockpit of the project and aggregated by period.
+func listInstanceServerMetrics(metrics []instanceServerMetric) plugin.HydrateFunc {
+	return func(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+		logLabel := d.Table.Name + ".listInstanceServerMetrics"
+
+		zone := d.EqualsQualString("zone")
+
+		parseZoneData, err := scw.ParseZone(zone)
+		if err != nil {
+			plugin.Logger(ctx).Error(logLabel, "zone_parsing_error", err)
+			return nil, err
+		}
+
+		quals := d.EqualsQuals
+		if quals["zone"] != nil && quals["zone"].GetStringValue() != zone {
+			return nil, nil
+		}
+
+		period := int64(instanceServerMetricDefaultPeriod)
+		if quals["period"] != nil {
+			period = quals["period"].GetInt64Value()
+		}
+		if period < 60 || period%60 != 0 {
+			return nil, fmt.Errorf("period must be a multiple of 60 seconds, got %d", period)
+		}
+		start, end := getInstanceServerMetricRange(d, period)
+		step, window := getInstanceServerMetricStep(start, end, period)
+		if start.Add(step).After(end) {
+			return nil, nil
+		}
+
+		// Create clients
+		client, err := getSessionConfig(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error(logLabel, "connection_error", err)
+			return nil, err
+		}
+		metricsClient, err := getCockpitMetricsClient(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error(logLabel, "connection_error", err)
+			return nil, err
+		}
+
+		// Create SDK objects for Scaleway Instance product
+		instanceApi := instance.NewAPI(client)
+
+		req := &instance.ListServersRequest{
+			Zone: parseZoneData,
+		}
+		if quals["server_id"] != nil {
+			req.Servers = []string{quals["server_id"].GetStringValue()}
+		}
+
+		// Scope the listing to the projects of the connection config, if any
+		projectIDs, err := getQualProjectIDs(ctx, d, "project")
+		if err != nil {
+			plugin.Logger(ctx).Error(logLabel, "project_resolution_error", err)
+			return nil, err
+		}
+
+		// The metrics of the servers are stored in the Cockpit of their project
+		serversByProject := map[string][]*instance.Server{}
+		var projects []string
+		for _, projectID := range projectIDs {
+			req.Project = projectID
+
+			resp, err := instanceApi.ListServers(req, scw.WithAllPages())
+			if err != nil {
+				plugin.Logger(ctx).Error(logLabel, "query_error", err)
+				return nil, err
+			}
+
+			for _, server := range resp.Servers {
+				if _, ok := serversByProject[server.Project]; !ok {
+					projects = append(projects, server.Project)
+				}
+				serversByProject[server.Project] = append(serversByProject[server.Project], server)
+			}
+		}
+
+		for _, project := range projects {
+			servers := serversByProject[project]
+
+			metricsURL, err := getCockpitMetricsURL(ctx, d, project)
+			if err != nil {
+				plugin.Logger(ctx).Error(logLabel, "cockpit_error", err)
+				return nil, err
+			}
+			// No metrics are stored when the Cockpit of the project isn't activated
+			if metricsURL == "" {
+				continue
+			}
+
+			serversByID := map[string]*instance.Server{}
+			ids := make([]string, len(servers))
+			for i, server := range servers {
+				serversByID[server.ID] = server
+				ids[i] = server.ID
+			}
+			sort.Strings(ids)
+			selector := fmt.Sprintf(`resource_id=~"%s"`, strings.Join(ids, "|"))
+
+			for _, metric := range metrics {
+				query := fmt.Sprintf(metric.Query, selector, formatPromDuration(window))
+
+				// The sample at a step is the rate over the preceding step
+				series, err := metricsClient.queryRange(ctx, metricsURL, query, start.Add(step), end, step)
+				if err != nil {
+					plugin.Logger(ctx).Error(logLabel, "query_error", err)
+					return nil, err
+				}
+
+				for _, s := range series {
+					server, ok := serversByID[s.Metric["resource_id"]]
+					if !ok {
+						continue
+					}
+
+					for _, item := range aggregateInstanceServerMetric(s, period, step) {
+						item.ServerID = server.ID
+						item.ServerName = server.Name
+						item.MetricName = metric.Name
+						item.Unit = metric.Unit
+						item.Zone = server.Zone
+						item.Project = server.Project
+						item.Organization = server.Organization
+						d.StreamListItem(ctx, item)
+
+						// Context can be cancelled due to manual cancellation or the limit has been hit
+						if d.RowsRemaining(ctx) == 0 {
+							return nil, nil
+						}
+					}
+				}
+			}
+		}
+
+		return nil, nil
+	}
+}
+
+// getInstanceServerMetricRange :: returns the range of time to query from the timestamp quals.
+// The start is aligned on the period, so the samples of a period are aggregated together.
+func getInstanceServerMetricRange(d *plugin.QueryData, period int64) (time.Time, time.Time) {
+	end := time.Now()
+	var start time.Time
+
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case quals.QualOperatorEqual:
+				start = timestamp
+				end = timestamp.Add(time.Duration(period) * time.Second)
+			case quals.QualOperatorGreater, quals.QualOperatorGreaterOrEqual:
+				start = timestamp
+			case quals.QualOperatorLess, quals.QualOperatorLessOrEqual:
+				end = timestamp
+			}
+		}
+	}
+
+	if start.IsZero() {
+		length := time.Duration(period*instanceServerMetricDefaultPeriods) * time.Second
+		if length > instanceServerMetricRetention {
+			length = instanceServerMetricRetention
+		}
+		start = end.Add(-length)
+	}
+
+	return start.Truncate(time.Duration(period) * time.Second), end
+}
+
+// getInstanceServerMetricStep :: returns the step of the range query and the window of the rates.
+// The samples are taken every minute, or less often to stay under the maximum number of samples,
+// with a step dividing the period so every period has the same number of samples.
+func getInstanceServerMetricStep(start time.Time, end time.Time, period int64) (time.Duration, time.Duration) {
+	minutes := period / 60
+	periods := int64(math.Ceil(end.Sub(start).Seconds() / float64(period)))
+
+	samplesPerPeriod := minutes
+	for samplesPerPeriod > 1 && (minutes%samplesPerPeriod != 0 || samplesPerPeriod*periods > instanceServerMetricMaxSamples) {
+		samplesPerPeriod--
+	}
+	step := time.Duration(period/samplesPerPeriod) * time.Second
+
+	// The rates cover the whole step, so no sample is left out of the averages
+	window := step
+	if window < instanceServerMetricMinRateWindow {
+		window = instanceServerMetricMinRateWindow
+	}
+	return step, window
+}
+
+// aggregateInstanceServerMetric :: returns the average, maximum and minimum of the samples of a
+// time series for each period, sorted by timestamp. A sample is the rate over the step ending at
+// its timestamp, so it is aggregated in the period of the start of the step.
+func aggregateInstanceServerMetric(series cockpitMetricSeries, period int64, step time.Duration) []instanceServerMetricInfo {
+	byTimestamp := map[int64]*instanceServerMetricInfo{}
+	var timestamps []int64
+
+	for _, sample := range series.Values {
+		timestamp, ok := sample[0].(float64)
+		if !ok {
+			continue
+		}
+		raw, ok := sample[1].(string)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+
+		bucket := (int64(timestamp) - int64(step/time.Second)) / period * period
+		item, ok := byTimestamp[bucket]
+		if !ok {
+			item = &instanceServerMetricInfo{
+				Timestamp: time.Unix(bucket, 0).UTC(),
+				Period:    period,
+				Maximum:   value,
+				Minimum:   value,
+			}
+			byTimestamp[bucket] = item
+			timestamps = append(timestamps, bucket)
+		}
+		item.Average += value
+		item.Maximum = math.Max(item.Maximum, value)
+		item.Minimum = math.Min(item.Minimum, value)
+		item.SampleCount++
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+	items := make([]instanceServerMetricInfo, len(timestamps))
+	for i, timestamp := range timestamps {
+		item := byTimestamp[timestamp]
+		item.Average /= float64(item.SampleCount)
+		items[i] = *item
+	}
+	return items
+}
+
+// formatPromDuration :: formats a duration as a PromQL duration in seconds, e.g. 120s
+func formatPromDuration(duration time.Duration) string {
+	return strconv.FormatInt(int64(duration/time.Second), 10) + "s"
+}
@@ -0,0 +1,70 @@
+package scaleway
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetInstanceServerMetricStep(t *testing.T) {
+	start := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		length time.Duration
+		period int64
+		step   time.Duration
+		window time.Duration
+	}{
+		// One sample a minute, the rates cover at least two minutes
+		{length: 24 * time.Hour, period: 300, step: time.Minute, window: 2 * time.Minute},
+		// Fewer samples a period to stay under the maximum number of samples
+		{length: 31 * 24 * time.Hour, period: 3600, step: 5 * time.Minute, window: 5 * time.Minute},
+		// At least one sample a period
+		{length: 31 * 24 * time.Hour, period: 300, step: 5 * time.Minute, window: 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		step, window := getInstanceServerMetricStep(start, start.Add(tt.length), tt.period)
+		if step != tt.step || window != tt.window {
+			t.Errorf("getInstanceServerMetricStep(%s, %d) = %s, %s, want %s, %s", tt.length, tt.period, step, window, tt.step, tt.window)
+		}
+	}
+}
+
+func TestAggregateInstanceServerMetric(t *testing.T) {
+	series := cockpitMetricSeries{
+		Values: [][2]interface{}{
+			// The sample at 00:00 is the rate of the last minute of the previous period
+			{float64(1696204800), "4"},
+			{float64(1696204860), "1"},
+			{float64(1696208400), "3"},
+			{float64(1696208460), "NaN"},
+			{float64(1696208520), "2"},
+		},
+	}
+
+	got := aggregateInstanceServerMetric(series, 3600, time.Minute)
+	if len(got) != 3 {
+		t.Fatalf("aggregateInstanceServerMetric() returned %d periods, want 3: %+v", len(got), got)
+	}
+	if got[0].Timestamp != time.Date(2023, 10, 1, 23, 0, 0, 0, time.UTC) || got[0].SampleCount != 1 {
+		t.Errorf("unexpected first period: %+v", got[0])
+	}
+	if got[1].Timestamp != time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC) || got[1].Average != 2 || got[1].Maximum != 3 || got[1].Minimum != 1 || got[1].SampleCount != 2 {
+		t.Errorf("unexpected second period: %+v", got[1])
+	}
+	// The NaN samples are left out
+	if got[2].Average != 2 || got[2].SampleCount != 1 {
+		t.Errorf("unexpected third period: %+v", got[2])
+	}
+}
+
+func TestInstanceServerMetricMissingToken(t *testing.T) {
+	t.Setenv("SCW_COCKPIT_TOKEN", "")
+
+	server := newFixtureServer(t, "instance_server_metric")
+	connection := newTestConnection(t, testConnectionConfig(server, ""))
+
+	_, err := executeQuery(t, testQuery{Connection: connection, Table: "scaleway_instance_server_metric_cpu"})
+	if err == nil || !strings.Contains(err.Error(), "cockpit_token") {
+		t.Errorf("expected a missing Cockpit token error, got %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// BuildOrganizationList :: return a list of matrix items, one per organization
+func BuildOrganizationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+
+	// cache matrix
+	cacheKey := "OrganizationListMatrix"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]map[string]interface{})
+	}
+
+	organizationIDs, err := getOrganizationIDs(ctx, d)
+	if err != nil {
+		// The list and get functions return the resolution error
+		plugin.Logger(ctx).Error("BuildOrganizationList", "organization_resolution_error", err)
+		return nil
+	}
+
+	matrix := make([]map[string]interface{}, len(organizationIDs))
+	for i, organizationID := range organizationIDs {
+		matrix[i] = map[string]interface{}{"organization_id": organizationID}
+	}
+
+	// set cache
+	d.ConnectionManager.Cache.Set(cacheKey, matrix)
+
+	return matrix
+}
+
+// getOrganizationIDs :: returns the organizations to query, in the following order:
+//  1. the organization_ids set in the connection config
+//  2. the organization_id set in the connection config or the Scaleway profile
+//  3. the organization of the API key bearer (user or application)
+func getOrganizationIDs(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	organizationIDs, err := getOrganizationIDsMemoized(ctx, d, &plugin.HydrateData{})
+	if err != nil {
+		return nil, err
+	}
+	return organizationIDs.([]string), nil
+}
+
+// getOrganizationIDsMemoized :: resolves the organizations once for the matrix and the list and get
+// functions, which return the resolution error if the matrix has no organization
+var getOrganizationIDsMemoized = plugin.HydrateFunc(getOrganizationIDsUncached).Memoize(memoizeByName("scaleway.organization_ids"))
+
+func getOrganizationIDsUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	scalewayConfig := GetConfig(d.Connection)
+	if len(scalewayConfig.OrganizationIDs) > 0 {
+		return unique(scalewayConfig.OrganizationIDs), nil
+	}
+
+	if organizationID := getOrganizationID(d); organizationID != nil {
+		return []string{*organizationID}, nil
+	}
+
+	// Resolve the organization from the API key used by the connection
+	creds, err := getCredentials(d)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	iamApi := iam.NewAPI(client)
+
+	apiKey, err := iamApi.GetAPIKey(&iam.GetAPIKeyRequest{
+		AccessKey: creds.AccessKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve the organization of the API key, set organization_id or organization_ids in scaleway.spc: %v", err)
+	}
+
+	organizationID, err := getAPIKeyOrganizationID(iamApi, apiKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve the organization of the API key, set organization_id or organization_ids in scaleway.spc: %v", err)
+	}
+
+	return []string{organizationID}, nil
+}
+
+// getAPIKeyOrganizationID :: returns the organization of the user or application bearing the API key
+func getAPIKeyOrganizationID(iamApi *iam.API, apiKey *iam.APIKey) (string, error) {
+	switch {
+	case apiKey.UserID != nil:
+		user, err := iamApi.GetUser(&iam.GetUserRequest{UserID: *apiKey.UserID})
+		if err != nil {
+			return "", err
+		}
+		return user.OrganizationID, nil
+	case apiKey.ApplicationID != nil:
+		application, err := iamApi.GetApplication(&iam.GetApplicationRequest{ApplicationID: *apiKey.ApplicationID})
+		if err != nil {
+			return "", err
+		}
+		return application.OrganizationID, nil
+	}
+	return "", fmt.Errorf("API key %s has no bearer", apiKey.AccessKey)
+}
+
+// getMatrixOrganizationID :: returns the organization of the current matrix item. Without
+// matrix item, the error resolving the organizations is returned, if any
+func getMatrixOrganizationID(ctx context.Context, d *plugin.QueryData) (string, error) {
+	organizationID := d.EqualsQualString("organization_id")
+	if organizationID == "" {
+		if _, err := getOrganizationIDs(ctx, d); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("missing organization_id in scaleway.spc or Scaleway config profile")
+	}
+	return organizationID, nil
+}
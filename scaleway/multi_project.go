@@ -0,0 +1,132 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/scaleway/scaleway-sdk-go/api/account/v3"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// getProjectIDs :: returns the projects list functions should be scoped to.
+// A single nil entry is returned when no projects are configured, so callers
+// can always loop over the result and leave the request unfiltered.
+func getProjectIDs(ctx context.Context, d *plugin.QueryData) ([]*string, error) {
+	cacheKey := "scaleway.projects"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]*string), nil
+	}
+
+	scalewayConfig := GetConfig(d.Connection)
+	if len(scalewayConfig.Projects) == 0 {
+		return []*string{nil}, nil
+	}
+
+	projectIDs, err := resolveProjectIDs(ctx, d, scalewayConfig.Projects)
+	if err != nil {
+		return nil, err
+	}
+
+	// No configured pattern matched any project, so nothing can be listed
+	if len(projectIDs) == 0 {
+		plugin.Logger(ctx).Warn("getProjectIDs", "no project matches the projects connection argument", scalewayConfig.Projects)
+	}
+
+	result := make([]*string, len(projectIDs))
+	for i := range projectIDs {
+		result[i] = scw.StringPtr(projectIDs[i])
+	}
+
+	// set cache
+	d.ConnectionManager.Cache.Set(cacheKey, result)
+
+	return result, nil
+}
+
+// resolveProjectIDs :: expands the projects patterns into project IDs.
+// Plain project IDs are used as is, any other pattern is matched against the
+// ID and the name of the projects of the connection's organizations.
+func resolveProjectIDs(ctx context.Context, d *plugin.QueryData, patterns []string) ([]string, error) {
+	var projectIDs, globs []string
+	for _, pattern := range patterns {
+		if validation.IsProjectID(pattern) {
+			projectIDs = append(projectIDs, pattern)
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid projects pattern %q in scaleway.spc: %v", pattern, err)
+		}
+		globs = append(globs, pattern)
+	}
+
+	if len(globs) == 0 {
+		return unique(projectIDs), nil
+	}
+
+	organizationIDs, err := getOrganizationIDs(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	accountApi := account.NewProjectAPI(client)
+
+	for _, organizationID := range organizationIDs {
+		resp, err := accountApi.ListProjects(&account.ProjectAPIListProjectsRequest{
+			OrganizationID: organizationID,
+		}, scw.WithAllPages())
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the projects of organization %s to resolve the projects connection argument: %v", organizationID, err)
+		}
+
+		for _, project := range resp.Projects {
+			for _, pattern := range globs {
+				matchID, _ := path.Match(pattern, project.ID)
+				matchName, _ := path.Match(pattern, project.Name)
+				if matchID || matchName {
+					projectIDs = append(projectIDs, project.ID)
+					break
+				}
+			}
+		}
+	}
+
+	return unique(projectIDs), nil
+}
+
+// isProjectInScope :: returns true if the project is one of the projects the connection is scoped to
+func isProjectInScope(projectIDs []*string, projectID string) bool {
+	for _, id := range projectIDs {
+		if id == nil || *id == projectID {
+			return true
+		}
+	}
+	return false
+}
+
+// getQualProjectIDs :: returns the projects list functions should be scoped to,
+// restricted to the project of the qual on the given column, if any.
+// Nothing can be listed when the project of the qual is out of the scope of the connection.
+func getQualProjectIDs(ctx context.Context, d *plugin.QueryData, column string) ([]*string, error) {
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.EqualsQuals[column] == nil {
+		return projectIDs, nil
+	}
+
+	projectID := d.EqualsQuals[column].GetStringValue()
+	if !isProjectInScope(projectIDs, projectID) {
+		return []*string{}, nil
+	}
+	return []*string{scw.StringPtr(projectID)}, nil
+}
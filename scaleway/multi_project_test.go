@@ -0,0 +1,41 @@
+package scaleway
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestResolveProjectIDs(t *testing.T) {
+	projectID := "1f3a8b4e-2c6d-4e1f-9a7b-5c8d2e4f6a1b"
+
+	// Plain project IDs are used without listing the organization projects
+	got, err := resolveProjectIDs(context.Background(), nil, []string{projectID, projectID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{projectID}) {
+		t.Errorf("resolveProjectIDs() = %v, want [%s]", got, projectID)
+	}
+
+	if _, err := resolveProjectIDs(context.Background(), nil, []string{"prod-["}); err == nil {
+		t.Error("expected an error for a malformed pattern")
+	}
+}
+
+func TestIsProjectInScope(t *testing.T) {
+	if !isProjectInScope([]*string{nil}, "any") {
+		t.Error("expected every project to be in scope when no projects are configured")
+	}
+	if !isProjectInScope([]*string{scw.StringPtr("a"), scw.StringPtr("b")}, "b") {
+		t.Error("expected project b to be in scope")
+	}
+	if isProjectInScope([]*string{scw.StringPtr("a")}, "b") {
+		t.Error("expected project b to be out of scope")
+	}
+	if isProjectInScope(nil, "a") {
+		t.Error("expected no project to be in scope when no configured project matched")
+	}
+}
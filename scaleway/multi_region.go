@@ -2,8 +2,8 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"path"
-	"slices"
 	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -20,7 +20,7 @@ func Zones() []scw.Zone {
 }
 
 // BuildRegionList :: return a list of matrix items, one per region
-func BuildRegionList(_ context.Context, d *plugin.QueryData) []map[string]interface{} {
+func BuildRegionList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
 
 	// cache matrix
 	cacheKey := "RegionListMatrix"
@@ -28,29 +28,15 @@ func BuildRegionList(_ context.Context, d *plugin.QueryData) []map[string]interf
 		return cachedData.([]map[string]interface{})
 	}
 
-	var allRegions []string
-
-	// retrieve regions from connection config
+	// retrieve regions from connection config, invalid patterns fail the
+	// connection when it is loaded
 	scalewayConfig := GetConfig(d.Connection)
-	if scalewayConfig.Regions != nil {
-		regions := Regions()
-		for _, pattern := range scalewayConfig.Regions {
-			for _, validRegion := range regions {
-				if ok, _ := path.Match(pattern, validRegion.String()); ok {
-					allRegions = append(allRegions, validRegion.String())
-				}
-			}
-		}
-	}
+	allRegions := matchRegions(scalewayConfig.Regions)
 
 	// Build regions matrix using config regions
 	if len(allRegions) > 0 {
 		uniqueRegions := unique(allRegions)
 
-		if len(getInvalidRegions(uniqueRegions)) > 0 {
-			panic("\n\nConnection config have invalid regions: " + strings.Join(getInvalidRegions(uniqueRegions), ","))
-		}
-
 		// validate regions list
 		matrix := make([]map[string]interface{}, len(uniqueRegions))
 		for i, region := range uniqueRegions {
@@ -64,7 +50,7 @@ func BuildRegionList(_ context.Context, d *plugin.QueryData) []map[string]interf
 	}
 
 	// Search for region configured using env, or use default region (i.e. fr-par)
-	defaultScalewayRegion := GetDefaultScalewayRegion(d)
+	defaultScalewayRegion := GetDefaultScalewayRegion(ctx, d)
 	matrix := []map[string]interface{}{
 		{"region": defaultScalewayRegion},
 	}
@@ -76,7 +62,7 @@ func BuildRegionList(_ context.Context, d *plugin.QueryData) []map[string]interf
 }
 
 // BuildZoneList :: return a list of matrix items, one per zone
-func BuildZoneList(_ context.Context, d *plugin.QueryData) []map[string]interface{} {
+func BuildZoneList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
 
 	// cache matrix
 	cacheKey := "ZoneListMatrix"
@@ -84,33 +70,33 @@ func BuildZoneList(_ context.Context, d *plugin.QueryData) []map[string]interfac
 		return cachedData.([]map[string]interface{})
 	}
 
-	var allRegions []string
-
-	// retrieve regions from connection config
 	scalewayConfig := GetConfig(d.Connection)
-	if scalewayConfig.Regions != nil {
-		regions := Regions()
-		for _, pattern := range scalewayConfig.Regions {
-			for _, validRegion := range regions {
-				if ok, _ := path.Match(pattern, validRegion.String()); ok {
-					allRegions = append(allRegions, validRegion.String())
-				}
-			}
+
+	// zones from connection config take precedence over the zones of the regions
+	if configZones := matchZones(scalewayConfig.Zones); len(configZones) > 0 {
+		matrix := make([]map[string]interface{}, len(configZones))
+		for i, zone := range configZones {
+			matrix[i] = map[string]interface{}{"zone": zone}
 		}
+
+		// set cache
+		d.ConnectionManager.Cache.Set(cacheKey, matrix)
+
+		return matrix
 	}
 
+	// retrieve regions from connection config, invalid patterns fail the
+	// connection when it is loaded
+	allRegions := matchRegions(scalewayConfig.Regions)
+
 	// Get default region
-	defaultRegion := GetDefaultScalewayRegion(d)
+	defaultRegion := GetDefaultScalewayRegion(ctx, d)
 	allRegions = append(allRegions, defaultRegion)
 
 	// Build regions matrix using config regions
 	if len(allRegions) > 0 {
 		uniqueRegions := unique(allRegions)
 
-		if len(getInvalidRegions(uniqueRegions)) > 0 {
-			panic("\n\nConnection config have invalid regions: " + strings.Join(getInvalidRegions(uniqueRegions), ","))
-		}
-
 		var allZones []scw.Zone
 		for _, region := range uniqueRegions {
 			zones := parseRegion(region).GetZones()
@@ -141,87 +127,108 @@ func BuildZoneList(_ context.Context, d *plugin.QueryData) []map[string]interfac
 }
 
 // GetDefaultScalewayRegion returns the default region for Scaleway project
-func GetDefaultScalewayRegion(d *plugin.QueryData) string {
+func GetDefaultScalewayRegion(ctx context.Context, d *plugin.QueryData) string {
 	// have we already created and cached the service?
 	serviceCacheKey := "GetDefaultScalewayRegion"
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(string)
 	}
-	var allRegions []string
-	for _, i := range Regions() {
-		allRegions = append(allRegions, i.String())
-	}
 
 	// get config info
 	scalewayConfig := GetConfig(d.Connection)
 
-	var regions []string
 	var region string
 
 	if scalewayConfig.Regions != nil {
-		regions = scalewayConfig.Regions
-		region = regions[0]
+		// Use the first region matching the connection config
+		if regions := matchRegions(scalewayConfig.Regions); len(regions) > 0 {
+			region = regions[0]
+		}
 	} else {
-		// Load credentials from environment variables
-		loadEnv := scw.LoadEnvProfile()
-
-		if loadEnv.DefaultRegion != nil {
-			region = *loadEnv.DefaultRegion
+		// Load default region from the Scaleway config profile or environment variables
+		profile, err := getScalewayProfile(d)
+		if err != nil {
+			plugin.Logger(ctx).Error("GetDefaultScalewayRegion", "profile_error", err)
+		} else if profile.DefaultRegion != nil {
+			region = *profile.DefaultRegion
 		}
+	}
 
-		if region != "" {
-			regions = []string{region}
-		}
+	// https://registry.terraform.io/providers/scaleway/scaleway/latest/docs#arguments-reference
+	if parseRegion(region) == "" {
+		region = "fr-par"
+	}
 
-		// https://registry.terraform.io/providers/scaleway/scaleway/latest/docs#arguments-reference
-		if !slices.Contains(allRegions, region) {
-			regions = []string{"fr-par"}
+	d.ConnectionManager.Cache.Set(serviceCacheKey, region)
+	return region
+}
+
+// matchRegions :: returns the valid regions matching any of the patterns
+func matchRegions(patterns []string) []string {
+	var matchedRegions []string
+	for _, pattern := range patterns {
+		for _, validRegion := range Regions() {
+			if ok, _ := path.Match(pattern, validRegion.String()); ok {
+				matchedRegions = append(matchedRegions, validRegion.String())
+			}
 		}
 	}
+	return unique(matchedRegions)
+}
 
-	validPatterns := []string{}
-	invalidPatterns := []string{}
-	for _, namePattern := range regions {
-		validRegions := []string{}
-		for _, validRegion := range allRegions {
-			if ok, _ := path.Match(namePattern, validRegion); ok {
-				validRegions = append(validRegions, validRegion)
+// matchZones :: returns the valid zones matching any of the patterns
+func matchZones(patterns []string) []string {
+	var matchedZones []string
+	for _, pattern := range patterns {
+		for _, validZone := range Zones() {
+			if ok, _ := path.Match(pattern, validZone.String()); ok {
+				matchedZones = append(matchedZones, validZone.String())
 			}
 		}
-		if len(validRegions) == 0 {
-			invalidPatterns = append(invalidPatterns, namePattern)
-		} else {
-			validPatterns = append(validPatterns, namePattern)
-		}
 	}
+	return unique(matchedZones)
+}
 
-	if len(validPatterns) == 0 {
-		panic("\nconnection config have invalid \"regions\": " + strings.Join(invalidPatterns, ", ") + ". Edit your connection configuration file and then restart Steampipe")
-	}
+// InvalidRegionsError is returned when some regions patterns of the connection config match no Scaleway region
+type InvalidRegionsError struct {
+	Patterns     []string
+	ValidRegions []string
+}
 
-	// https://registry.terraform.io/providers/scaleway/scaleway/latest/docs#arguments-reference
-	if !slices.Contains(allRegions, region) {
-		region = "fr-par"
+func (e *InvalidRegionsError) Error() string {
+	return fmt.Sprintf("connection config has invalid regions: %s. Valid regions are: %s", strings.Join(e.Patterns, ", "), strings.Join(e.ValidRegions, ", "))
+}
+
+// getInvalidRegionPatterns :: returns the patterns which are malformed or match no region
+func getInvalidRegionPatterns(patterns []string) []string {
+	invalidPatterns := []string{}
+	for _, pattern := range patterns {
+		if len(matchRegions([]string{pattern})) == 0 {
+			invalidPatterns = append(invalidPatterns, pattern)
+		}
 	}
+	return invalidPatterns
+}
 
-	d.ConnectionManager.Cache.Set(serviceCacheKey, region)
-	return region
+// InvalidZonesError is returned when some zones patterns of the connection config match no Scaleway zone
+type InvalidZonesError struct {
+	Patterns   []string
+	ValidZones []string
 }
 
-// Return invalid regions from a region list
-func getInvalidRegions(regions []string) []string {
-	var allRegions []string
-	for _, i := range Regions() {
-		allRegions = append(allRegions, i.String())
-	}
+func (e *InvalidZonesError) Error() string {
+	return fmt.Sprintf("connection config has invalid zones: %s. Valid zones are: %s", strings.Join(e.Patterns, ", "), strings.Join(e.ValidZones, ", "))
+}
 
-	invalidRegions := []string{}
-	for _, region := range regions {
-		if !slices.Contains(allRegions, region) {
-			invalidRegions = append(invalidRegions, region)
+// getInvalidZonePatterns :: returns the patterns which are malformed or match no zone
+func getInvalidZonePatterns(patterns []string) []string {
+	invalidPatterns := []string{}
+	for _, pattern := range patterns {
+		if len(matchZones([]string{pattern})) == 0 {
+			invalidPatterns = append(invalidPatterns, pattern)
 		}
 	}
-	return invalidRegions
+	return invalidPatterns
 }
 
 // Returns a list of unique items
@@ -0,0 +1,52 @@
+package scaleway
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMatchRegions(t *testing.T) {
+	if got := matchRegions([]string{"fr-par", "nl-*", "fr-par", "xx-yyy"}); !reflect.DeepEqual(got, []string{"fr-par", "nl-ams"}) {
+		t.Errorf("matchRegions() = %v", got)
+	}
+	if got := matchRegions([]string{"*"}); len(got) != len(Regions()) {
+		t.Errorf("expected * to match every region, got %v", got)
+	}
+}
+
+func TestValidateConnectionConfig(t *testing.T) {
+	if err := validateConnectionConfig(scalewayConfig{Regions: []string{"fr-par", "pl-*"}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateConnectionConfig(scalewayConfig{}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := validateConnectionConfig(scalewayConfig{Regions: []string{"fr-par", "fr-pa", "nl-["}})
+	var regionsErr *InvalidRegionsError
+	if !errors.As(err, &regionsErr) {
+		t.Fatalf("expected an InvalidRegionsError, got %v", err)
+	}
+	if !reflect.DeepEqual(regionsErr.Patterns, []string{"fr-pa", "nl-["}) {
+		t.Errorf("unexpected invalid patterns: %v", regionsErr.Patterns)
+	}
+	if len(regionsErr.ValidRegions) != len(Regions()) {
+		t.Errorf("expected every valid region to be listed, got %v", regionsErr.ValidRegions)
+	}
+}
+
+func TestMatchZones(t *testing.T) {
+	if got := matchZones([]string{"fr-par-[12]", "nl-ams-1", "fr-par-9"}); !reflect.DeepEqual(got, []string{"fr-par-1", "fr-par-2", "nl-ams-1"}) {
+		t.Errorf("matchZones() = %v", got)
+	}
+
+	err := validateConnectionConfig(scalewayConfig{Zones: []string{"fr-par-*", "fr-par-9"}})
+	var zonesErr *InvalidZonesError
+	if !errors.As(err, &zonesErr) {
+		t.Fatalf("expected an InvalidZonesError, got %v", err)
+	}
+	if !reflect.DeepEqual(zonesErr.Patterns, []string{"fr-par-9"}) {
+		t.Errorf("unexpected invalid patterns: %v", zonesErr.Patterns)
+	}
+}
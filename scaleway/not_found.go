@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
@@ -12,6 +13,11 @@ func is404Error(err error) bool {
 	return isHTTPCodeError(err, http.StatusNotFound) || errors.As(err, &notFoundError)
 }
 
+func is403Error(err error) bool {
+	permissionsDeniedError := &scw.PermissionsDeniedError{}
+	return isHTTPCodeError(err, http.StatusForbidden) || errors.As(err, &permissionsDeniedError)
+}
+
 func isHTTPCodeError(err error, statusCode int) bool {
 	if err == nil {
 		return false
@@ -21,5 +27,11 @@ func isHTTPCodeError(err error, statusCode int) bool {
 	if errors.As(err, &responseError) && responseError.StatusCode == statusCode {
 		return true
 	}
+
+	// Object Storage errors returned by the aws-sdk S3 client
+	var requestFailure awserr.RequestFailure
+	if errors.As(err, &requestFailure) && requestFailure.StatusCode() == statusCode {
+		return true
+	}
 	return false
 }
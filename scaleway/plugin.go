@@ -5,6 +5,7 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
 )
 
 const pluginName = "steampipe-plugin-scaleway"
@@ -18,31 +19,157 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		ConnectionConfigSchema: &plugin.ConnectionConfigSchema{
 			NewInstance: ConfigInstance,
 		},
-		TableMap: map[string]*plugin.Table{
-			"scaleway_account_project":         tableScalewayAccountProject(ctx),
-			"scaleway_account_ssh_key":         tableScalewayAccountSSHKey(ctx),
-			"scaleway_baremetal_server":        tableScalewayBaremetalServer(ctx),
-			"scaleway_billing_consumption":     tableScalewayBillingConsumption(ctx),
-			"scaleway_billing_invoice":         tableScalewayBillingInvoice(ctx),
-			"scaleway_iam_api_key":             tableScalewayIamAPIKey(ctx),
-			"scaleway_iam_user":                tableScalewayIamUser(ctx),
-			"scaleway_instance_image":          tableScalewayInstanceImage(ctx),
-			"scaleway_instance_ip":             tableScalewayInstanceIP(ctx),
-			"scaleway_instance_security_group": tableScalewayInstanceSecurityGroup(ctx),
-			"scaleway_instance_server":         tableScalewayInstanceServer(ctx),
-			"scaleway_instance_snapshot":       tableScalewayInstanceSnapshot(ctx),
-			"scaleway_instance_volume":         tableScalewayInstanceVolume(ctx),
-			"scaleway_kubernetes_cluster":      tableScalewayKubernetesCluster(ctx),
-			"scaleway_kubernetes_node":         tableScalewayKubernetesNode(ctx),
-			"scaleway_kubernetes_pool":         tableScalewayKubernetesPool(ctx),
-			"scaleway_object_bucket":           tableScalewayObjectBucket(ctx),
-			"scaleway_rdb_database":            tableScalewayRDBDatabase(ctx),
-			"scaleway_rdb_instance":            tableScalewayRDBInstance(ctx),
-			"scaleway_registry_image":          tableScalewayRegistryImage(ctx),
-			"scaleway_registry_namespace":      tableScalewayRegistryNamespace(ctx),
-			"scaleway_vpc_private_network":     tableScalewayVPCPrivateNetwork(ctx),
+		// The table map is built per connection, so that an invalid connection
+		// config fails the connection when it is loaded. The tables are the
+		// same for every connection
+		SchemaMode:   plugin.SchemaModeDynamic,
+		TableMapFunc: pluginTableDefinitions,
+		// Tables are tagged with the Scaleway product they query ("service" tag).
+		// Limiters can be overridden by name with `limiter` blocks in scaleway.spc,
+		// or per connection with the rate_limits argument, the tables of the connection
+		// being then tagged with the overridden limiters ("connection_rate_limits" tag)
+		RateLimiters: []*rate_limiter.Definition{
+			{
+				Name:       "scaleway_instance",
+				FillRate:   20,
+				BucketSize: 20,
+				Scope:      []string{"connection", "zone"},
+				Where:      "service = 'instance' and connection_rate_limits not like '%scaleway_instance%'",
+			},
+			{
+				Name:       "scaleway_baremetal",
+				FillRate:   10,
+				BucketSize: 10,
+				Scope:      []string{"connection", "zone"},
+				// scaleway_server_event is an instance table also listing the bare metal server events
+				Where: "(service = 'baremetal' or table = 'scaleway_server_event') and connection_rate_limits not like '%scaleway_baremetal%'",
+			},
+			{
+				Name:       "scaleway_vpc",
+				FillRate:   10,
+				BucketSize: 10,
+				Scope:      []string{"connection", "zone"},
+				Where:      "service = 'vpc' and connection_rate_limits not like '%scaleway_vpc%'",
+			},
+			{
+				Name:       "scaleway_k8s",
+				FillRate:   10,
+				BucketSize: 10,
+				Scope:      []string{"connection", "region"},
+				Where:      "service = 'k8s' and connection_rate_limits not like '%scaleway_k8s%'",
+			},
+			{
+				Name:       "scaleway_rdb",
+				FillRate:   10,
+				BucketSize: 10,
+				Scope:      []string{"connection", "region"},
+				Where:      "service = 'rdb' and connection_rate_limits not like '%scaleway_rdb%'",
+			},
+			{
+				Name:       "scaleway_registry",
+				FillRate:   10,
+				BucketSize: 10,
+				Scope:      []string{"connection", "region"},
+				Where:      "service = 'registry' and connection_rate_limits not like '%scaleway_registry%'",
+			},
+			{
+				Name:       "scaleway_s3",
+				FillRate:   50,
+				BucketSize: 50,
+				Scope:      []string{"connection", "region"},
+				Where:      "service = 's3' and connection_rate_limits not like '%scaleway_s3%'",
+			},
+			// The concurrency of the Object Storage hydrate calls is limited apart from
+			// their rate, so that it is kept when the connection overrides the rate
+			{
+				Name:           "scaleway_s3_concurrency",
+				MaxConcurrency: 10,
+				Scope:          []string{"connection", "region"},
+				Where:          "service = 's3'",
+			},
+			{
+				Name:       "scaleway_billing",
+				FillRate:   5,
+				BucketSize: 5,
+				Scope:      []string{"connection"},
+				Where:      "service = 'billing' and connection_rate_limits not like '%scaleway_billing%'",
+			},
+			{
+				Name:       "scaleway_iam",
+				FillRate:   10,
+				BucketSize: 10,
+				Scope:      []string{"connection"},
+				Where:      "(service = 'iam' or service = 'account') and connection_rate_limits not like '%scaleway_iam%'",
+			},
+			{
+				Name:       "scaleway_marketplace",
+				FillRate:   10,
+				BucketSize: 10,
+				Scope:      []string{"connection"},
+				Where:      "service = 'marketplace' and connection_rate_limits not like '%scaleway_marketplace%'",
+			},
 		},
 	}
 
 	return p
 }
+
+// pluginTableDefinitions :: returns the tables of the plugin, once the connection config is validated
+func pluginTableDefinitions(ctx context.Context, d *plugin.TableMapData) (map[string]*plugin.Table, error) {
+	scalewayConfig := GetConfig(d.Connection)
+	if err := validateConnectionConfig(scalewayConfig); err != nil {
+		return nil, err
+	}
+
+	tables := tableDefinitions(ctx)
+
+	// The rate limiters overridden by the connection config don't apply to its tables
+	if rateLimits := getConnectionRateLimitsTag(scalewayConfig); rateLimits != "" {
+		for _, table := range tables {
+			table.Tags[connectionRateLimitsTag] = rateLimits
+		}
+	}
+	return tables, nil
+}
+
+// tableDefinitions :: returns the tables of the plugin
+func tableDefinitions(ctx context.Context) map[string]*plugin.Table {
+	return map[string]*plugin.Table{
+		"scaleway_account_project":                tableScalewayAccountProject(ctx),
+		"scaleway_account_ssh_key":                tableScalewayAccountSSHKey(ctx),
+		"scaleway_baremetal_server":               tableScalewayBaremetalServer(ctx),
+		"scaleway_billing_consumption":            tableScalewayBillingConsumption(ctx),
+		"scaleway_billing_invoice":                tableScalewayBillingInvoice(ctx),
+		"scaleway_iam_api_key":                    tableScalewayIamAPIKey(ctx),
+		"scaleway_iam_user":                       tableScalewayIamUser(ctx),
+		"scaleway_instance_image":                 tableScalewayInstanceImage(ctx),
+		"scaleway_instance_ip":                    tableScalewayInstanceIP(ctx),
+		"scaleway_instance_placement_group":       tableScalewayInstancePlacementGroup(ctx),
+		"scaleway_instance_private_nic":           tableScalewayInstancePrivateNIC(ctx),
+		"scaleway_instance_security_group":        tableScalewayInstanceSecurityGroup(ctx),
+		"scaleway_instance_security_group_rule":   tableScalewayInstanceSecurityGroupRule(ctx),
+		"scaleway_instance_server":                tableScalewayInstanceServer(ctx),
+		"scaleway_instance_server_metric_cpu":     tableScalewayInstanceServerMetricCPU(ctx),
+		"scaleway_instance_server_metric_disk":    tableScalewayInstanceServerMetricDisk(ctx),
+		"scaleway_instance_server_metric_network": tableScalewayInstanceServerMetricNetwork(ctx),
+		"scaleway_instance_server_type":           tableScalewayInstanceServerType(ctx),
+		"scaleway_instance_server_user_data":      tableScalewayInstanceServerUserData(ctx),
+		"scaleway_instance_snapshot":              tableScalewayInstanceSnapshot(ctx),
+		"scaleway_instance_volume":                tableScalewayInstanceVolume(ctx),
+		"scaleway_kubernetes_cluster":             tableScalewayKubernetesCluster(ctx),
+		"scaleway_kubernetes_node":                tableScalewayKubernetesNode(ctx),
+		"scaleway_kubernetes_pool":                tableScalewayKubernetesPool(ctx),
+		"scaleway_marketplace_image":              tableScalewayMarketplaceImage(ctx),
+		"scaleway_marketplace_local_image":        tableScalewayMarketplaceLocalImage(ctx),
+		"scaleway_object_bucket":                  tableScalewayObjectBucket(ctx),
+		"scaleway_object_bucket_object":           tableScalewayObjectBucketObject(ctx),
+		"scaleway_object_bucket_object_version":   tableScalewayObjectBucketObjectVersion(ctx),
+		"scaleway_object_bucket_policy_statement": tableScalewayObjectBucketPolicyStatement(ctx),
+		"scaleway_rdb_database":                   tableScalewayRDBDatabase(ctx),
+		"scaleway_rdb_instance":                   tableScalewayRDBInstance(ctx),
+		"scaleway_registry_image":                 tableScalewayRegistryImage(ctx),
+		"scaleway_registry_namespace":             tableScalewayRegistryNamespace(ctx),
+		"scaleway_server_event":                   tableScalewayServerEvent(ctx),
+		"scaleway_vpc_private_network":            tableScalewayVPCPrivateNetwork(ctx),
+	}
+}
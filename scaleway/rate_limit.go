@@ -0,0 +1,165 @@
+package scaleway
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// connectionRateLimitsTag is the table tag listing the rate limiters of the plugin
+// overridden by the rate_limits argument of the connection config, so that the
+// `where` clause of these limiters excludes the tables of the connection
+const connectionRateLimitsTag = "connection_rate_limits"
+
+// objectRateLimiter is the rate limiter of the Object Storage (S3) requests
+const objectRateLimiter = "scaleway_s3"
+
+// productRateLimiters :: the rate limiters of the plugin by Scaleway API product,
+// as found in the path of the requests, e.g. /instance/v1/zones/fr-par-1/servers
+var productRateLimiters = map[string]string{
+	"instance": "scaleway_instance",
+	// The IPAM and Cockpit requests are sent by the instance tables
+	"ipam":        "scaleway_instance",
+	"cockpit":     "scaleway_instance",
+	"baremetal":   "scaleway_baremetal",
+	"vpc":         "scaleway_vpc",
+	"k8s":         "scaleway_k8s",
+	"rdb":         "scaleway_rdb",
+	"registry":    "scaleway_registry",
+	"billing":     "scaleway_billing",
+	"iam":         "scaleway_iam",
+	"account":     "scaleway_iam",
+	"marketplace": "scaleway_marketplace",
+}
+
+// isConnectionRateLimiter :: returns true if the rate_limits argument of the connection config can override the rate limiter
+func isConnectionRateLimiter(name string) bool {
+	if name == objectRateLimiter {
+		return true
+	}
+	for _, limiter := range productRateLimiters {
+		if limiter == name {
+			return true
+		}
+	}
+	return false
+}
+
+// getInvalidRateLimits :: returns the rate_limits of the connection config which override no rate limiter or aren't positive
+func getInvalidRateLimits(rateLimits map[string]float64) []string {
+	invalidRateLimits := []string{}
+	for name, limit := range rateLimits {
+		if !isConnectionRateLimiter(name) || limit <= 0 {
+			invalidRateLimits = append(invalidRateLimits, name)
+		}
+	}
+	sort.Strings(invalidRateLimits)
+	return invalidRateLimits
+}
+
+// InvalidRateLimitsError is returned when some rate_limits of the connection config override no rate limiter or aren't positive
+type InvalidRateLimitsError struct {
+	Names []string
+}
+
+func (e *InvalidRateLimitsError) Error() string {
+	return fmt.Sprintf("connection config has invalid rate_limits: %s. The limits must be positive requests per second, for the limiters: %s", strings.Join(e.Names, ", "), strings.Join(connectionRateLimiterNames(), ", "))
+}
+
+// connectionRateLimiterNames :: returns the sorted names of the rate limiters the connection config can override
+func connectionRateLimiterNames() []string {
+	limiters := map[string]bool{objectRateLimiter: true}
+	for _, limiter := range productRateLimiters {
+		limiters[limiter] = true
+	}
+
+	names := make([]string, 0, len(limiters))
+	for name := range limiters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getConnectionRateLimitsTag :: returns the value of the connectionRateLimitsTag of the tables of the connection
+func getConnectionRateLimitsTag(scalewayConfig scalewayConfig) string {
+	names := make([]string, 0, len(scalewayConfig.RateLimits))
+	for name := range scalewayConfig.RateLimits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// scalewayRequestRateLimiter :: returns the rate limiter of a Scaleway API request,
+// and its zone or region, if any
+func scalewayRequestRateLimiter(req *http.Request) (string, string) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	var locality string
+	for i := 1; i < len(segments)-1; i++ {
+		if segments[i] == "zones" || segments[i] == "regions" {
+			locality = segments[i+1]
+			break
+		}
+	}
+	return productRateLimiters[segments[0]], locality
+}
+
+// rateLimitTransport is an http.RoundTripper waiting, before sending a request,
+// for the rate limit of the connection config of the request rate limiter.
+// The requests are limited per zone or region, as the rate limiters of the plugin
+type rateLimitTransport struct {
+	transport  http.RoundTripper
+	rateLimits map[string]float64
+	// requestRateLimiter returns the rate limiter of a request, and its zone or region
+	requestRateLimiter func(req *http.Request) (string, string)
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newRateLimitTransport(transport http.RoundTripper, rateLimits map[string]float64, requestRateLimiter func(req *http.Request) (string, string)) *rateLimitTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &rateLimitTransport{
+		transport:          transport,
+		rateLimits:         rateLimits,
+		requestRateLimiter: requestRateLimiter,
+		limiters:           map[string]*rate.Limiter{},
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := t.limiter(req); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// limiter :: returns the limiter of the request, or nil if the connection config doesn't override its rate limiter
+func (t *rateLimitTransport) limiter(req *http.Request) *rate.Limiter {
+	name, locality := t.requestRateLimiter(req)
+	limit, ok := t.rateLimits[name]
+	if !ok {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := name + "/" + locality
+	if _, ok := t.limiters[key]; !ok {
+		// The bucket size is the fill rate, as for the rate limiters of the plugin
+		t.limiters[key] = rate.NewLimiter(rate.Limit(limit), int(math.Ceil(limit)))
+	}
+	return t.limiters[key]
+}
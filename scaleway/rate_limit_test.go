@@ -0,0 +1,121 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+func TestScalewayRequestRateLimiter(t *testing.T) {
+	tests := []struct {
+		path     string
+		limiter  string
+		locality string
+	}{
+		{"/instance/v1/zones/fr-par-1/servers", "scaleway_instance", "fr-par-1"},
+		{"/ipam/v1/regions/fr-par/ips", "scaleway_instance", "fr-par"},
+		{"/baremetal/v1/zones/fr-par-2/servers/1/events", "scaleway_baremetal", "fr-par-2"},
+		{"/k8s/v1/regions/nl-ams/clusters", "scaleway_k8s", "nl-ams"},
+		{"/account/v3/projects", "scaleway_iam", ""},
+		{"/billing/v2beta1/invoices", "scaleway_billing", ""},
+		{"/unknown/v1/regions/fr-par/things", "", "fr-par"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			limiter, locality := scalewayRequestRateLimiter(req)
+			if limiter != tt.limiter || locality != tt.locality {
+				t.Errorf("scalewayRequestRateLimiter() = (%q, %q), want (%q, %q)", limiter, locality, tt.limiter, tt.locality)
+			}
+		})
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(nil, map[string]float64{"scaleway_instance": 20}, scalewayRequestRateLimiter)}
+	get := func(path string) {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// The requests of the other limiters and zones are not delayed by the limited zone
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		get("/instance/v1/zones/fr-par-1/servers")
+	}
+	get("/instance/v1/zones/fr-par-2/servers")
+	get("/k8s/v1/regions/fr-par/clusters")
+
+	// 20 requests are sent at once, then 20 per second
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("25 requests took %s, want at least 200ms", elapsed)
+	}
+}
+
+// TestConnectionRateLimits ensures the rate limiters overridden by the connection config don't apply to its tables
+func TestConnectionRateLimits(t *testing.T) {
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	definitions := Plugin(ctx).RateLimiters
+	for _, definition := range definitions {
+		if err := definition.Initialise(); err != nil {
+			t.Fatalf("invalid limiter %s: %v", definition.Name, err)
+		}
+		// The concurrency limiters are kept when the connection overrides the rate
+		if definition.FillRate > 0 && !isConnectionRateLimiter(definition.Name) {
+			t.Errorf("limiter %s can't be overridden by the connection config", definition.Name)
+		}
+	}
+
+	if _, failure := loadTestConnection(t, `rate_limits = { scaleway_s3 = 5, scaleway_baremetal = 1 }`); failure != "" {
+		t.Fatalf("connection failed: %s", failure)
+	}
+
+	tables, err := pluginTableDefinitions(ctx, &plugin.TableMapData{
+		Connection: &plugin.Connection{Config: scalewayConfig{RateLimits: map[string]float64{"scaleway_s3": 5, "scaleway_baremetal": 1}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string][]string{
+		"scaleway_object_bucket":    {"scaleway_s3_concurrency"},
+		"scaleway_baremetal_server": nil,
+		"scaleway_server_event":     {"scaleway_instance"},
+		"scaleway_instance_server":  {"scaleway_instance"},
+	} {
+		table := tables[name]
+		if got := table.Tags[connectionRateLimitsTag]; got != "scaleway_baremetal,scaleway_s3" {
+			t.Errorf("table %s is tagged %s = %q", name, connectionRateLimitsTag, got)
+		}
+
+		scopeValues := map[string]string{"table": name}
+		for key, value := range table.Tags {
+			scopeValues[key] = value
+		}
+
+		var limiters []string
+		for _, definition := range definitions {
+			if definition.SatisfiesFilters(scopeValues) {
+				limiters = append(limiters, definition.Name)
+			}
+		}
+		if len(limiters) != len(want) || (len(want) > 0 && limiters[0] != want[0]) {
+			t.Errorf("table %s is limited by %v, want %v", name, limiters, want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package scaleway
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const (
+	// defaultMaxRetries is the number of retries performed if max_retries is not set
+	defaultMaxRetries = 5
+
+	// defaultMinRetryDelay is the initial backoff delay if min_retry_delay is not set
+	defaultMinRetryDelay = 100 * time.Millisecond
+
+	// maxRetryDelay caps the backoff delay and the Retry-After delay
+	maxRetryDelay = 30 * time.Second
+)
+
+// retryableStatusCodes are the HTTP status codes of the responses that are retried
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// isRetryableError :: returns true if the error is a Scaleway API or Object
+// Storage error that is worth retrying, i.e. throttling or a server error
+func isRetryableError(err error) bool {
+	for _, statusCode := range retryableStatusCodes {
+		if isHTTPCodeError(err, statusCode) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryOptions holds the retry settings of a connection
+type retryOptions struct {
+	MaxRetries    int
+	MinRetryDelay time.Duration
+}
+
+// getRetryOptions :: returns the retry settings from the connection config
+func getRetryOptions(scalewayConfig scalewayConfig) retryOptions {
+	opts := retryOptions{
+		MaxRetries:    defaultMaxRetries,
+		MinRetryDelay: defaultMinRetryDelay,
+	}
+	if scalewayConfig.MaxRetries != nil {
+		opts.MaxRetries = *scalewayConfig.MaxRetries
+	}
+	if scalewayConfig.MinRetryDelay != nil {
+		opts.MinRetryDelay = time.Duration(*scalewayConfig.MinRetryDelay) * time.Millisecond
+	}
+	return opts
+}
+
+// retryDelay :: returns the delay before the given retry attempt (starting at 0),
+// using an exponential backoff with jitter. A zero minimum delay disables the
+// backoff. The Retry-After delay, if any, is used when it is longer than the
+// backoff, both being capped at maxRetryDelay.
+func retryDelay(attempt int, minDelay time.Duration, retryAfter time.Duration) time.Duration {
+	var delay time.Duration
+	if minDelay > 0 {
+		delay = maxRetryDelay
+		if attempt < 30 && minDelay<<attempt > 0 && minDelay<<attempt < maxRetryDelay {
+			delay = minDelay << attempt
+		}
+	}
+
+	// Add up to 50% of jitter to spread retries of concurrent hydrate calls
+	if delay > 1 {
+		delay += time.Duration(rand.Int63n(int64(delay / 2)))
+	}
+
+	if retryAfter > delay {
+		return min(retryAfter, maxRetryDelay)
+	}
+	return delay
+}
+
+// parseRetryAfter :: returns the delay requested by a Retry-After header,
+// expressed either in seconds or as an HTTP date
+func parseRetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+//// SCALEWAY API CLIENT
+
+// retryTransport is an http.RoundTripper retrying the Scaleway API requests
+// that failed with a retryable status code
+type retryTransport struct {
+	transport http.RoundTripper
+	options   retryOptions
+}
+
+func newRetryTransport(transport http.RoundTripper, options retryOptions) *retryTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &retryTransport{transport: transport, options: options}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || attempt >= t.options.MaxRetries {
+			return resp, err
+		}
+
+		if !isRetryableError(&scw.ResponseError{StatusCode: resp.StatusCode, Status: resp.Status}) {
+			return resp, nil
+		}
+
+		// The request body has already been consumed, a new one is needed to retry
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req.Body = body
+		}
+
+		delay := retryDelay(attempt, t.options.MinRetryDelay, parseRetryAfter(resp.Header))
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+//// OBJECT STORAGE CLIENT
+
+// objectRetryer is the aws-sdk retryer of the Object Storage client, sharing
+// the retryable errors and the backoff of the Scaleway API client
+type objectRetryer struct {
+	client.DefaultRetryer
+	options retryOptions
+}
+
+func newObjectRetryer(options retryOptions) objectRetryer {
+	return objectRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: options.MaxRetries},
+		options:        options,
+	}
+}
+
+// ShouldRetry returns true for retryable errors, and for the connection
+// errors the default aws-sdk retryer already retries
+func (r objectRetryer) ShouldRetry(req *request.Request) bool {
+	if r.NumMaxRetries == 0 {
+		return false
+	}
+	if isRetryableError(req.Error) {
+		return true
+	}
+	return r.DefaultRetryer.ShouldRetry(req)
+}
+
+// RetryRules returns the delay before retrying the request
+func (r objectRetryer) RetryRules(req *request.Request) time.Duration {
+	var retryAfter time.Duration
+	if req.HTTPResponse != nil {
+		retryAfter = parseRetryAfter(req.HTTPResponse.Header)
+	}
+	return retryDelay(req.RetryCount, r.options.MinRetryDelay, retryAfter)
+}
@@ -0,0 +1,111 @@
+package scaleway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"scaleway throttling", &scw.ResponseError{StatusCode: http.StatusTooManyRequests}, true},
+		{"scaleway unavailable", &scw.ResponseError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"scaleway not found", &scw.ResponseError{StatusCode: http.StatusNotFound}, false},
+		{"object storage slow down", awserr.NewRequestFailure(awserr.New("SlowDown", "", nil), http.StatusServiceUnavailable, ""), true},
+		{"object storage access denied", awserr.NewRequestFailure(awserr.New("AccessDenied", "", nil), http.StatusForbidden, ""), false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err); got != tt.want {
+				t.Errorf("isRetryableError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(nil, retryOptions{MaxRetries: 5, MinRetryDelay: time.Millisecond})}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransportMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(nil, retryOptions{MaxRetries: 2, MinRetryDelay: time.Millisecond})}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	if delay := retryDelay(0, 100*time.Millisecond, 0); delay < 100*time.Millisecond || delay > 150*time.Millisecond {
+		t.Errorf("unexpected first retry delay: %s", delay)
+	}
+	if delay := retryDelay(3, 100*time.Millisecond, 0); delay < 800*time.Millisecond || delay > 1200*time.Millisecond {
+		t.Errorf("unexpected fourth retry delay: %s", delay)
+	}
+	if delay := retryDelay(50, 100*time.Millisecond, 0); delay < maxRetryDelay {
+		t.Errorf("expected delay to be capped at %s, got %s", maxRetryDelay, delay)
+	}
+	if delay := retryDelay(0, 100*time.Millisecond, 10*time.Second); delay != 10*time.Second {
+		t.Errorf("expected Retry-After delay to be used, got %s", delay)
+	}
+	if delay := retryDelay(0, 100*time.Millisecond, time.Hour); delay != maxRetryDelay {
+		t.Errorf("expected Retry-After delay to be capped at %s, got %s", maxRetryDelay, delay)
+	}
+
+	// A zero minimum delay disables the backoff, not the Retry-After delay
+	if delay := retryDelay(3, 0, 0); delay != 0 {
+		t.Errorf("expected no delay without minimum delay, got %s", delay)
+	}
+	if delay := retryDelay(3, 0, time.Second); delay != time.Second {
+		t.Errorf("expected Retry-After delay to be used without minimum delay, got %s", delay)
+	}
+}
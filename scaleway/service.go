@@ -3,6 +3,11 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -20,36 +25,42 @@ func getSessionConfig(ctx context.Context, d *plugin.QueryData) (*scw.Client, er
 		return cachedData.(*scw.Client), nil
 	}
 
-	var accessKey, secretKey string
-
-	opts := []scw.ClientOption{}
-
-	// Load credentials from environment variables
-	loadEnv := scw.LoadEnvProfile()
-
-	if loadEnv.AccessKey != nil && loadEnv.SecretKey != nil {
-		accessKey = *loadEnv.AccessKey
-		secretKey = *loadEnv.SecretKey
+	// Resolve credentials from the connection config, profile or environment variables
+	creds, err := getCredentials(d)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get scaleway config
-	scalewayConfig := GetConfig(d.Connection)
+	// Load defaults from the Scaleway config profile and environment variables
+	profile, err := getScalewayProfile(d)
+	if err != nil {
+		return nil, err
+	}
 
-	if scalewayConfig.AccessKey != nil && scalewayConfig.SecretKey == nil {
-		return nil, fmt.Errorf("partial credentials found in connection config, missing: secret_key")
-	} else if scalewayConfig.SecretKey != nil && scalewayConfig.AccessKey == nil {
-		return nil, fmt.Errorf("partial credentials found in connection config, missing: access_key")
-	} else if scalewayConfig.AccessKey != nil && scalewayConfig.SecretKey != nil {
-		accessKey = *scalewayConfig.AccessKey
-		secretKey = *scalewayConfig.SecretKey
+	// Default organization, region and zone come from the profile, while the
+	// connection config takes precedence for the organization
+	opts := []scw.ClientOption{
+		scw.WithProfile(profile),
+		scw.WithAuth(creds.AccessKey, creds.SecretKey),
+	}
+	if organizationID := getOrganizationID(d); organizationID != nil {
+		opts = append(opts, scw.WithDefaultOrganizationID(*organizationID))
 	}
 
-	// No creds
-	if accessKey == "" && secretKey == "" {
-		return nil, fmt.Errorf("both access_key and secret_key must be configured")
+	// Override the Scaleway API URL, e.g. to target a mock API
+	scalewayConfig := GetConfig(d.Connection)
+	if scalewayConfig.APIURL != nil {
+		opts = append(opts, scw.WithAPIURL(*scalewayConfig.APIURL))
 	}
 
-	opts = append(opts, scw.WithAuth(*scalewayConfig.AccessKey, *scalewayConfig.SecretKey))
+	// Retry throttled and failed requests with an exponential backoff, each
+	// attempt waiting for the rate limits of the connection config, if any
+	opts = append(opts, scw.WithHTTPClient(&http.Client{
+		Transport: newRetryTransport(
+			newRateLimitTransport(newHTTPTransport(), scalewayConfig.RateLimits, scalewayRequestRateLimiter),
+			getRetryOptions(scalewayConfig),
+		),
+	}))
 
 	// Create client
 	client, err := scw.NewClient(opts...)
@@ -71,42 +82,40 @@ func getObjectSessionConfig(ctx context.Context, d *plugin.QueryData, region str
 		return cachedData.(*s3.S3), nil
 	}
 
-	var accessKey, secretKey string
-
-	// Load credentials from environment variables
-	loadEnv := scw.LoadEnvProfile()
-
-	if loadEnv.AccessKey != nil && loadEnv.SecretKey != nil {
-		accessKey = *loadEnv.AccessKey
-		secretKey = *loadEnv.SecretKey
+	// Resolve credentials from the connection config, profile or environment variables
+	creds, err := getCredentials(d)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get scaleway config
 	scalewayConfig := GetConfig(d.Connection)
 
-	if scalewayConfig.AccessKey != nil && scalewayConfig.SecretKey == nil {
-		return nil, fmt.Errorf("partial credentials found in connection config, missing: secret_key")
-	} else if scalewayConfig.SecretKey != nil && scalewayConfig.AccessKey == nil {
-		return nil, fmt.Errorf("partial credentials found in connection config, missing: access_key")
-	} else if scalewayConfig.AccessKey != nil && scalewayConfig.SecretKey != nil {
-		accessKey = *scalewayConfig.AccessKey
-		secretKey = *scalewayConfig.SecretKey
-	}
-
-	// No creds
-	if accessKey == "" && secretKey == "" {
-		return nil, fmt.Errorf("both access_key and secret_key must be configured")
-	}
-
 	// session default configuration
 	sessionOptions := session.Options{
 		Config: aws.Config{
 			Region:      &region,
-			Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
-			Endpoint:    scw.StringPtr("https://s3." + region + ".scw.cloud"),
+			Credentials: credentials.NewStaticCredentials(creds.AccessKey, creds.SecretKey, ""),
+			Endpoint:    scw.StringPtr(getObjectEndpoint(scalewayConfig, region)),
+			// Retry throttled and failed requests with the same backoff as the Scaleway API client
+			Retryer: newObjectRetryer(getRetryOptions(scalewayConfig)),
 		},
 	}
 
+	// Wait for the rate limit of the connection config, if any
+	if _, ok := scalewayConfig.RateLimits[objectRateLimiter]; ok {
+		sessionOptions.Config.HTTPClient = &http.Client{
+			Transport: newRateLimitTransport(nil, scalewayConfig.RateLimits, func(*http.Request) (string, string) {
+				return objectRateLimiter, region
+			}),
+		}
+	}
+
+	// Custom endpoints (e.g. MinIO) generally don't support virtual-hosted-style requests
+	if scalewayConfig.S3Endpoint != nil {
+		sessionOptions.Config.S3ForcePathStyle = aws.Bool(true)
+	}
+
 	s, err := session.NewSessionWithOptions(sessionOptions)
 	if err != nil {
 		return nil, err
@@ -118,3 +127,64 @@ func getObjectSessionConfig(ctx context.Context, d *plugin.QueryData, region str
 
 	return client, nil
 }
+
+// getCockpitMetricsClient :: returns the client querying the Cockpit metrics with the Cockpit token
+// of the connection config, or else of the SCW_COCKPIT_TOKEN environment variable
+func getCockpitMetricsClient(ctx context.Context, d *plugin.QueryData) (*cockpitMetricsClient, error) {
+	// Load client from cache
+	sessionCacheKey := "scaleway.cockpitmetricsclient"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(sessionCacheKey); ok {
+		return cachedData.(*cockpitMetricsClient), nil
+	}
+
+	// Get scaleway config
+	scalewayConfig := GetConfig(d.Connection)
+
+	token := os.Getenv("SCW_COCKPIT_TOKEN")
+	if scalewayConfig.CockpitToken != nil {
+		token = *scalewayConfig.CockpitToken
+	}
+	if token == "" {
+		return nil, fmt.Errorf("a Cockpit token is required to query the metrics, set the cockpit_token connection argument or the SCW_COCKPIT_TOKEN environment variable")
+	}
+
+	client := &cockpitMetricsClient{
+		token: token,
+		// Retry throttled and failed requests with the same backoff and rate limits as the Scaleway API client.
+		// The metrics are queried by the instance tables, from the data source of each region
+		httpClient: &http.Client{
+			Transport: newRetryTransport(
+				newRateLimitTransport(newHTTPTransport(), scalewayConfig.RateLimits, func(req *http.Request) (string, string) {
+					return productRateLimiters["cockpit"], req.URL.Host
+				}),
+				getRetryOptions(scalewayConfig),
+			),
+		},
+	}
+
+	// save client in cache
+	d.ConnectionManager.Cache.Set(sessionCacheKey, client)
+
+	return client, nil
+}
+
+// getObjectEndpoint :: returns the Object Storage endpoint for the given region.
+// The "{region}" placeholder of a custom s3_endpoint is replaced by the region.
+func getObjectEndpoint(scalewayConfig scalewayConfig, region string) string {
+	if scalewayConfig.S3Endpoint == nil {
+		return "https://s3." + region + ".scw.cloud"
+	}
+	return strings.ReplaceAll(*scalewayConfig.S3Endpoint, "{region}", region)
+}
+
+// newHTTPTransport :: returns the HTTP transport of the Scaleway API client,
+// with the same timeouts as the Scaleway SDK default client
+func newHTTPTransport() *http.Transport {
+	return &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: 5 * time.Second}).DialContext,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		MaxIdleConnsPerHost:   20,
+	}
+}
@@ -2,7 +2,6 @@ package scaleway
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/scaleway/scaleway-sdk-go/api/account/v3"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -16,8 +15,10 @@ import (
 
 func tableScalewayAccountProject(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:        "scaleway_account_project",
-		Description: "A Scaleway Account Project.",
+		Name:              "scaleway_account_project",
+		Description:       "A Scaleway Account Project.",
+		Tags:              map[string]string{"service": "account"},
+		GetMatrixItemFunc: BuildOrganizationList,
 		List: &plugin.ListConfig{
 			Hydrate: listAccountProjects,
 			KeyColumns: []*plugin.KeyColumn{
@@ -25,6 +26,10 @@ func tableScalewayAccountProject(_ context.Context) *plugin.Table {
 					Name:    "name",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "organization_id",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -75,31 +80,47 @@ func listAccountProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	// Create client
 	client, err := getSessionConfig(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_project.listProjects", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_account_project.listAccountProjects", "connection_error", err)
 		return nil, err
 	}
 
 	// Create SDK objects for Scaleway Account product
 	accountApi := account.NewProjectAPI(client)
 
-	// Get organisationID from config to request IAM API
-	organisationId := GetConfig(d.Connection).OrganizationID
-	if organisationId == nil {
-		err := fmt.Errorf("missing organization_id in scaleway.spc")
-		plugin.Logger(ctx).Error("scaleway_project.listProjects", "query_error", err)
+	// Get organisationID of the matrix item to request Account API
+	organisationId, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_account_project.listAccountProjects", "query_error", err)
 		return nil, err
 	}
 
 	quals := d.EqualsQuals
 
 	req := &account.ProjectAPIListProjectsRequest{
-		OrganizationID: *organisationId,
+		OrganizationID: organisationId,
 		Page:           scw.Int32Ptr(1),
 	}
 	// Additional filters
 	if quals["name"] != nil {
 		req.Name = scw.StringPtr(quals["name"].GetStringValue())
 	}
+
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_account_project.listAccountProjects", "project_resolution_error", err)
+		return nil, err
+	}
+	for _, projectID := range projectIDs {
+		if projectID == nil {
+			break
+		}
+		req.ProjectIDs = append(req.ProjectIDs, *projectID)
+	}
+	if len(req.ProjectIDs) == 0 && len(projectIDs) == 0 {
+		return nil, nil
+	}
+
 	// Retrieve the list of servers
 	maxResult := int64(100)
 
@@ -117,7 +138,7 @@ func listAccountProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	for {
 		resp, err := accountApi.ListProjects(req)
 		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_project.listProjects", "query_error", err)
+			plugin.Logger(ctx).Error("scaleway_account_project.listAccountProjects", "query_error", err)
 			return nil, err
 		}
 
@@ -162,6 +183,12 @@ func getAccountProject(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, nil
 	}
 
+	organisationId, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_account_project.getAccountProject", "query_error", err)
+		return nil, err
+	}
+
 	data, err := accountApi.GetProject(&account.ProjectAPIGetProjectRequest{
 		ProjectID: projectId,
 	})
@@ -173,5 +200,10 @@ func getAccountProject(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, err
 	}
 
+	// The project belongs to another organization of the matrix
+	if data.OrganizationID != organisationId {
+		return nil, nil
+	}
+
 	return data, nil
 }
@@ -17,6 +17,7 @@ func tableScalewayAccountSSHKey(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "scaleway_account_ssh_key",
 		Description: "SSH keys to access servers provisioned on Scaleway.",
+		Tags:        map[string]string{"service": "account"},
 		List: &plugin.ListConfig{
 			Hydrate: listAccountSSHKeys,
 			KeyColumns: []*plugin.KeyColumn{
@@ -99,7 +100,7 @@ func listAccountSSHKeys(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	// Create client
 	client, err := getSessionConfig(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_instance.listAccountSSHKeys", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_account_ssh_key.listAccountSSHKeys", "connection_error", err)
 		return nil, err
 	}
 
@@ -127,31 +128,44 @@ func listAccountSSHKeys(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_account_ssh_key.listAccountSSHKeys", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := accountApi.ListSSHKeys(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_instance.listAccountSSHKeys", "query_error", err)
-		}
+	for _, projectID := range projectIDs {
+		req.ProjectID = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
 
-		for _, key := range resp.SSHKeys {
-			d.StreamListItem(ctx, key)
+		for {
+			resp, err := accountApi.ListSSHKeys(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_account_ssh_key.listAccountSSHKeys", "query_error", err)
+				return nil, err
+			}
 
-			// Increase the resource count by 1
-			count++
+			for _, key := range resp.SSHKeys {
+				d.StreamListItem(ctx, key)
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Increase the resource count by 1
+				count++
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
-		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 
+		}
 	}
 
 	return nil, nil
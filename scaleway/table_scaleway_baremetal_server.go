@@ -17,6 +17,7 @@ func tableScalewayBaremetalServer(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:              "scaleway_baremetal_server",
 		Description:       "A Compute Instance bare metal is a physical server in Scaleway.",
+		Tags:              map[string]string{"service": "baremetal"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listBaremetalServers,
@@ -136,11 +137,13 @@ func tableScalewayBaremetalServer(_ context.Context) *plugin.Table {
 				Name:        "project",
 				Description: "The ID of the project where the server resides.",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
 			},
 			{
 				Name:        "organization",
 				Description: "The ID of the organization where the server resides.",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrganizationID"),
 			},
 
 			// Steampipe standard columns
@@ -212,31 +215,43 @@ func listBaremetalServers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_baremetal_server.listBaremetalServers", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := baremetalApi.ListServers(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_baremetal_server.listBaremetalServers", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.ProjectID = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
 
-		for _, baremetal := range resp.Servers {
-			d.StreamListItem(ctx, baremetal)
+		for {
+			resp, err := baremetalApi.ListServers(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_baremetal_server.listBaremetalServers", "query_error", err)
+				return nil, err
+			}
+
+			for _, baremetal := range resp.Servers {
+				d.StreamListItem(ctx, baremetal)
 
-			// Increase the resource count by 1
-			count++
+				// Increase the resource count by 1
+				count++
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
@@ -249,7 +264,7 @@ func getBaremetalServer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	parseZoneData, err := scw.ParseZone(zone)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_baremetal_server.listBaremetalServers", "zone_parsing_error", err)
+		plugin.Logger(ctx).Error("scaleway_baremetal_server.getBaremetalServer", "zone_parsing_error", err)
 		return nil, err
 	}
 
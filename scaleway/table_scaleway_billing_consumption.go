@@ -2,7 +2,6 @@ package scaleway
 
 import (
 	"context"
-	"fmt"
 
 	billing "github.com/scaleway/scaleway-sdk-go/api/billing/v2beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -16,8 +15,10 @@ import (
 
 func tableScalewayBillingConsumption(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:        "scaleway_billing_consumption",
-		Description: "Scaleway Billing Consumption",
+		Name:              "scaleway_billing_consumption",
+		Description:       "Scaleway Billing Consumption",
+		Tags:              map[string]string{"service": "billing"},
+		GetMatrixItemFunc: BuildOrganizationList,
 		List: &plugin.ListConfig{
 			Hydrate: listBillingConsumption,
 			KeyColumns: []*plugin.KeyColumn{
@@ -29,6 +30,10 @@ func tableScalewayBillingConsumption(_ context.Context) *plugin.Table {
 					Name:    "billing_period",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "organization_id",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Columns: []*plugin.Column{
@@ -79,6 +84,14 @@ func tableScalewayBillingConsumption(_ context.Context) *plugin.Table {
 				Description: "Consumed quantity.",
 				Type:        proto.ColumnType_JSON,
 			},
+
+			// Scaleway standard columns
+			{
+				Name:        "organization_id",
+				Description: "The organization ID of the consumption.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem("organization_id"),
+			},
 		},
 	}
 }
@@ -96,10 +109,9 @@ func listBillingConsumption(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	// Create SDK objects for Scaleway Billing
 	billingApi := billing.NewAPI(client)
 
-	// Get organisationID from config to request IAM API
-	organisationId := GetConfig(d.Connection).OrganizationID
-	if organisationId == nil {
-		err := fmt.Errorf("missing organization_id in scaleway.spc")
+	// Get organisationID of the matrix item to request Billing API
+	organisationId, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
 		plugin.Logger(ctx).Error("scaleway_billing_consumption.listBillingConsumption", "query_error", err)
 		return nil, err
 	}
@@ -108,7 +120,7 @@ func listBillingConsumption(ctx context.Context, d *plugin.QueryData, _ *plugin.
 
 	req := &billing.ListConsumptionsRequest{
 		Page:           scw.Int32Ptr(1),
-		OrganizationID: organisationId,
+		OrganizationID: &organisationId,
 	}
 
 	// Additional filters
@@ -132,6 +144,14 @@ func listBillingConsumption(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
+	// The API accepts either an organization or a project filter, so project
+	// scoping from the connection config is applied on the returned rows
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_billing_consumption.listBillingConsumption", "project_resolution_error", err)
+		return nil, err
+	}
+
 	var count int
 
 	for {
@@ -142,10 +162,14 @@ func listBillingConsumption(ctx context.Context, d *plugin.QueryData, _ *plugin.
 		}
 
 		for _, consumption := range resp.Consumptions {
-			d.StreamListItem(ctx, consumption)
 			// Increase the resource count by 1
 			count++
 
+			if !isProjectInScope(projectIDs, consumption.ProjectID) {
+				continue
+			}
+			d.StreamListItem(ctx, consumption)
+
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
 				return nil, nil
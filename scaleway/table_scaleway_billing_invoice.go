@@ -16,8 +16,10 @@ import (
 func tableScalewayBillingInvoice(ctx context.Context) *plugin.Table {
 	plugin.Logger(ctx).Debug("Initializing Scaleway invoices table")
 	return &plugin.Table{
-		Name:        "scaleway_billing_invoice",
-		Description: "Scaleway Billing Invoice",
+		Name:              "scaleway_billing_invoice",
+		Description:       "Scaleway Billing Invoice",
+		Tags:              map[string]string{"service": "billing"},
+		GetMatrixItemFunc: BuildOrganizationList,
 		List: &plugin.ListConfig{
 			Hydrate: listScalewayInvoices,
 			KeyColumns: []*plugin.KeyColumn{
@@ -159,24 +161,17 @@ func listScalewayInvoices(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	billingAPI := billing.NewAPI(client)
 
 	// Prepare the request
-	req := &billing.ListInvoicesRequest{}
-
-	// Get the organization_id from the config
-	scalewayConfig := GetConfig(d.Connection)
-	var organizationID string
-	if scalewayConfig.OrganizationID != nil {
-		organizationID = *scalewayConfig.OrganizationID
-	}
-
-	// Check if organization_id is specified in the query parameter
-	if d.EqualsQualString("organization_id") != "" {
-		organizationID = d.EqualsQualString("organization_id")
+	req := &billing.ListInvoicesRequest{
+		Page: scw.Int32Ptr(1),
 	}
 
-	// Set the organization_id in the request if it's available
-	if organizationID != "" {
-		req.OrganizationID = &organizationID
+	// Get the organization_id of the matrix item, or specified in the query parameter
+	organizationID, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_billing_invoice.listScalewayInvoices", "query_error", err)
+		return nil, err
 	}
+	req.OrganizationID = &organizationID
 
 	if d.EqualsQualString("type") != "" {
 		req.InvoiceType = billing.InvoiceType(d.EqualsQualString("type"))
@@ -253,9 +248,17 @@ func getScalewayInvoice(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	resp, err := billingAPI.GetInvoice(req)
 	if err != nil {
 		plugin.Logger(ctx).Error("scaleway_billing_invoice.getScalewayInvoice", "api_error", err)
+		if is404Error(err) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
+	// The invoice belongs to another organization of the matrix
+	if resp != nil && resp.OrganizationID != d.EqualsQualString("organization_id") {
+		return nil, nil
+	}
+
 	if resp != nil {
 		return resp, nil
 	}
@@ -15,8 +15,10 @@ import (
 
 func tableScalewayIamAPIKey(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:        "scaleway_iam_api_key",
-		Description: "API keys allow you to securely connect to scaleway console in your organization.",
+		Name:              "scaleway_iam_api_key",
+		Description:       "API keys allow you to securely connect to scaleway console in your organization.",
+		Tags:              map[string]string{"service": "iam"},
+		GetMatrixItemFunc: BuildOrganizationList,
 		List: &plugin.ListConfig{
 			Hydrate: listIamAPIKeys,
 			KeyColumns: []*plugin.KeyColumn{
@@ -24,6 +26,10 @@ func tableScalewayIamAPIKey(_ context.Context) *plugin.Table {
 					Name:    "access_key",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "organization_id",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -92,6 +98,14 @@ func tableScalewayIamAPIKey(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Description").Transform(transform.ToString),
 			},
+
+			// Scaleway standard columns
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization where the API key resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem("organization_id"),
+			},
 		},
 	}
 }
@@ -109,12 +123,16 @@ func listIamAPIKeys(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	// Create SDK objects for Scaleway IAM product
 	iamApi := iam.NewAPI(client)
 
-	// Get organisationID from config to request IAM API
-	organisationId := GetConfig(d.Connection).OrganizationID
+	// Get organisationID of the matrix item to request IAM API
+	organisationId, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_iam_api_key.listIamAPIKeys", "query_error", err)
+		return nil, err
+	}
 
 	req := &iam.ListAPIKeysRequest{
 		Page:           scw.Int32Ptr(1),
-		OrganizationID: organisationId,
+		OrganizationID: &organisationId,
 	}
 
 	// Retrieve the list of servers
@@ -135,6 +153,7 @@ func listIamAPIKeys(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		resp, err := iamApi.ListAPIKeys(req)
 		if err != nil {
 			plugin.Logger(ctx).Error("scaleway_iam_api_key.listIamAPIKeys", "query_error", err)
+			return nil, err
 		}
 
 		for _, key := range resp.APIKeys {
@@ -179,6 +198,12 @@ func getIamAPIKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 		return nil, nil
 	}
 
+	organisationId, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_iam_api_key.getIamAPIKey", "query_error", err)
+		return nil, err
+	}
+
 	data, err := iamApi.GetAPIKey(&iam.GetAPIKeyRequest{
 		AccessKey: accessKey,
 	})
@@ -190,5 +215,15 @@ func getIamAPIKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 		return nil, err
 	}
 
+	// The API key belongs to another organization of the matrix
+	keyOrganisationId, err := getAPIKeyOrganizationID(iamApi, data)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_iam_api_key.getIamAPIKey", "query_error", err)
+		return nil, err
+	}
+	if keyOrganisationId != organisationId {
+		return nil, nil
+	}
+
 	return data, nil
 }
@@ -15,10 +15,18 @@ import (
 
 func tableScalewayIamUser(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:        "scaleway_iam_user",
-		Description: "Users allow you to connect to scaleway console in your organization.",
+		Name:              "scaleway_iam_user",
+		Description:       "Users allow you to connect to scaleway console in your organization.",
+		Tags:              map[string]string{"service": "iam"},
+		GetMatrixItemFunc: BuildOrganizationList,
 		List: &plugin.ListConfig{
 			Hydrate: listIamUsers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "organization_id",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		Get: &plugin.GetConfig{
 			Hydrate:    getIamUser,
@@ -73,6 +81,12 @@ func tableScalewayIamUser(_ context.Context) *plugin.Table {
 			},
 
 			// Scaleway standard columns
+			{
+				Name:        "organization_id",
+				Description: "The ID of the organization where the user resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrganizationID"),
+			},
 			{
 				Name:        "organization",
 				Description: "The ID of the organization where the server resides.",
@@ -104,12 +118,16 @@ func listIamUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	// Create SDK objects for Scaleway IAM product
 	iamApi := iam.NewAPI(client)
 
-	// Get organisationID from config to request IAM API
-	organisationId := GetConfig(d.Connection).OrganizationID
+	// Get organisationID of the matrix item to request IAM API
+	organisationId, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_iam_user.listIamUsers", "query_error", err)
+		return nil, err
+	}
 
 	req := &iam.ListUsersRequest{
 		Page:           scw.Int32Ptr(1),
-		OrganizationID: organisationId,
+		OrganizationID: &organisationId,
 	}
 
 	// Retrieve the list of servers
@@ -130,6 +148,7 @@ func listIamUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 		resp, err := iamApi.ListUsers(req)
 		if err != nil {
 			plugin.Logger(ctx).Error("scaleway_iam_user.listIamUsers", "query_error", err)
+			return nil, err
 		}
 
 		for _, key := range resp.Users {
@@ -174,6 +193,12 @@ func getIamUser(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return nil, nil
 	}
 
+	organisationId, err := getMatrixOrganizationID(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_iam_user.getIamUser", "query_error", err)
+		return nil, err
+	}
+
 	data, err := iamApi.GetUser(&iam.GetUserRequest{
 		UserID: userId,
 	})
@@ -185,5 +210,10 @@ func getIamUser(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return nil, err
 	}
 
+	// The user belongs to another organization of the matrix
+	if data.OrganizationID != organisationId {
+		return nil, nil
+	}
+
 	return data, nil
 }
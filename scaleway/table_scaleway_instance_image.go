@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 
@@ -15,8 +16,9 @@ import (
 
 func tableScalewayInstanceImage(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_instance_image",
-		Description:   "Images are backups of your instances.",
+		Name:              "scaleway_instance_image",
+		Description:       "Images are backups of your instances.",
+		Tags:              map[string]string{"service": "instance"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listInstanceImages,
@@ -30,10 +32,27 @@ func tableScalewayInstanceImage(_ context.Context) *plugin.Table {
 					Require:   plugin.Optional,
 					Operators: []string{"<>", "="},
 				},
+				{
+					Name:    "arch",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "tags",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
 				{
 					Name:    "zone",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -89,13 +108,19 @@ func tableScalewayInstanceImage(_ context.Context) *plugin.Table {
 				Name:        "extra_volumes",
 				Description: "Describes the extra volumes for this image.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("DefaultBootscript"),
+				Transform:   transform.FromField("ExtraVolumes"),
 			},
 			{
 				Name:        "root_volume",
 				Description: "Describes the root volume in this image.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "tags",
+				Description: "A list of tags associated with the image.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
 
 			// Scaleway standard columns
 			{
@@ -160,6 +185,16 @@ func listInstanceImages(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	if quals["name"] != nil {
 		req.Name = scw.StringPtr(quals["name"].GetStringValue())
 	}
+	if quals["arch"] != nil {
+		req.Arch = scw.StringPtr(quals["arch"].GetStringValue())
+	}
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+	// The API returns the images having all the tags
+	if tags := getQualsExistsValues(d, "tags"); len(tags) > 0 {
+		req.Tags = scw.StringPtr(strings.Join(tags, ","))
+	}
 
 	if d.EqualsQuals["public"] != nil {
 		req.Public = scw.BoolPtr(d.EqualsQuals["public"].GetBoolValue())
@@ -190,31 +225,43 @@ func listInstanceImages(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	}
 	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_image.listInstanceImages", "project_resolution_error", err)
+		return nil, err
+	}
+
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
 
-	for {
-		resp, err := instanceApi.ListImages(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_instance_image.listInstanceImages", "query_error", err)
-			return nil, err
-		}
+		var count int
+
+		for {
+			resp, err := instanceApi.ListImages(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_image.listInstanceImages", "query_error", err)
+				return nil, err
+			}
 
-		for _, image := range resp.Images {
-			d.StreamListItem(ctx, image)
+			for _, image := range resp.Images {
+				d.StreamListItem(ctx, image)
 
-			// Increase the resource count by 1
-			count++
+				// Increase the resource count by 1
+				count++
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
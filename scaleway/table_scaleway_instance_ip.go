@@ -15,16 +15,30 @@ import (
 
 func tableScalewayInstanceIP(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_instance_ip",
-		Description:   "A flexible IP address is an IP address which you hold independently of any server.",
+		Name:              "scaleway_instance_ip",
+		Description:       "A flexible IP address is an IP address which you hold independently of any server.",
+		Tags:              map[string]string{"service": "instance"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listInstanceIPs,
 			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "tags",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
 				{
 					Name:    "zone",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -119,6 +133,14 @@ func listInstanceIPs(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 		Zone: parseZoneData,
 		Page: scw.Int32Ptr(1),
 	}
+	// Additional filters
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+	// The API returns the IP addresses having all the tags
+	if tags := getQualsExistsValues(d, "tags"); len(tags) > 0 {
+		req.Tags = tags
+	}
 
 	// Retrieve the list of IPs
 	maxResult := int64(100)
@@ -132,31 +154,43 @@ func listInstanceIPs(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 	}
 	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_ip.listInstanceIPs", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := instanceApi.ListIPs(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_instance_ip.listInstanceIPs", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
 
-		for _, ip := range resp.IPs {
-			d.StreamListItem(ctx, ip)
+		var count int
 
-			// Increase the resource count by 1
-			count++
+		for {
+			resp, err := instanceApi.ListIPs(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_ip.listInstanceIPs", "query_error", err)
+				return nil, err
+			}
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+			for _, ip := range resp.IPs {
+				d.StreamListItem(ctx, ip)
+
+				// Increase the resource count by 1
+				count++
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
@@ -0,0 +1,316 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayInstancePlacementGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_placement_group",
+		Description:       "A placement group places instances on the same or on different hypervisors, to reduce latency or to improve availability.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate: listInstancePlacementGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "tags",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
+				{
+					Name:    "zone",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getInstancePlacementGroup,
+			KeyColumns: plugin.AllColumns([]string{"id", "zone"}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The user-defined name of the placement group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "An unique identifier of the placement group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "policy_type",
+				Description: "The policy type of the placement group, max_availability places the servers on different hypervisors, low_latency on the same hypervisor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyType").Transform(transform.ToString),
+			},
+			{
+				Name:        "policy_mode",
+				Description: "The policy mode of the placement group, enforced prevents the servers from starting if the policy cannot be respected, optional doesn't.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyMode").Transform(transform.ToString),
+			},
+			{
+				Name:        "policy_respected",
+				Description: "Indicates whether the policy of the placement group is respected by all its servers, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PolicyRespected"),
+			},
+			{
+				Name:        "server_ids",
+				Description: "The IDs of the servers of the placement group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getInstancePlacementGroupServers,
+				Transform:   transform.FromValue().Transform(placementGroupServerIDs),
+			},
+			{
+				Name:        "servers",
+				Description: "The servers of the placement group, and whether each of them respects the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getInstancePlacementGroupServers,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags",
+				Description: "A list of tags associated with the placement group.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "zone",
+				Description: "Specifies the zone where the placement group resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
+			},
+			{
+				Name:        "project",
+				Description: "The ID of the project where the placement group resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization",
+				Description: "The ID of the organization where the placement group resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listInstancePlacementGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	zone := d.EqualsQualString("zone")
+
+	parseZoneData, err := scw.ParseZone(zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.listInstancePlacementGroups", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	if quals["zone"] != nil && quals["zone"].GetStringValue() != zone {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.listInstancePlacementGroups", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	req := &instance.ListPlacementGroupsRequest{
+		Zone: parseZoneData,
+		Page: scw.Int32Ptr(1),
+	}
+	// Additional filters
+	if quals["name"] != nil {
+		req.Name = scw.StringPtr(quals["name"].GetStringValue())
+	}
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+	// The API returns the placement groups having all the tags
+	if tags := getQualsExistsValues(d, "tags"); len(tags) > 0 {
+		req.Tags = tags
+	}
+
+	// Retrieve the list of placement groups
+	maxResult := int64(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
+
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.listInstancePlacementGroups", "project_resolution_error", err)
+		return nil, err
+	}
+
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
+
+		for {
+			resp, err := instanceApi.ListPlacementGroups(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_placement_group.listInstancePlacementGroups", "query_error", err)
+				return nil, err
+			}
+
+			for _, placementGroup := range resp.PlacementGroups {
+				d.StreamListItem(ctx, placementGroup)
+
+				// Increase the resource count by 1
+				count++
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getInstancePlacementGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	zone := d.EqualsQualString("zone")
+
+	parseZoneData, err := scw.ParseZone(zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.getInstancePlacementGroup", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	if d.EqualsQuals["zone"].GetStringValue() != zone {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.getInstancePlacementGroup", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	// No inputs
+	if id == "" {
+		return nil, nil
+	}
+
+	data, err := instanceApi.GetPlacementGroup(&instance.GetPlacementGroupRequest{
+		PlacementGroupID: id,
+		Zone:             parseZoneData,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.getInstancePlacementGroup", "query_error", err)
+		if is404Error(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return data.PlacementGroup, nil
+}
+
+func getInstancePlacementGroupServers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	placementGroup := h.Item.(*instance.PlacementGroup)
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.getInstancePlacementGroupServers", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	data, err := instanceApi.GetPlacementGroupServers(&instance.GetPlacementGroupServersRequest{
+		PlacementGroupID: placementGroup.ID,
+		Zone:             placementGroup.Zone,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_placement_group.getInstancePlacementGroupServers", "query_error", err)
+		// The placement group may be deleted between the list and the hydrate
+		if is404Error(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return data.Servers, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func placementGroupServerIDs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	servers, ok := d.Value.([]*instance.PlacementGroupServer)
+	if !ok {
+		return nil, nil
+	}
+
+	ids := make([]string, len(servers))
+	for i, server := range servers {
+		ids[i] = server.ID
+	}
+	return ids, nil
+}
@@ -0,0 +1,311 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayInstancePrivateNIC(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_private_nic",
+		Description:       "A private NIC is the network interface attaching a server to a private network.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate: listInstancePrivateNICs,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "private_network_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "server_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "mac_address",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "zone",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "An unique identifier of the private NIC.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "server_id",
+				Description: "The ID of the server the private NIC is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerID"),
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the private NIC is attached to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "private_network_id",
+				Description: "The ID of the private network the private NIC is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateNetworkID"),
+			},
+			{
+				Name:        "mac_address",
+				Description: "The MAC address of the private NIC.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MacAddress"),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the private NIC.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State").Transform(transform.ToString),
+			},
+			{
+				Name:        "ip_addresses",
+				Description: "The IP addresses of the private NIC in CIDR notation, as booked in IPAM. Null if no IP is booked for the private NIC, e.g. when the private network doesn't use IPAM, or if IPAM is not available.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getInstancePrivateNICIPs,
+				Transform:   transform.FromValue().Transform(ipamIPAddresses),
+			},
+			{
+				Name:        "ips",
+				Description: "The IPs of the private NIC, as booked in IPAM. Null if no IP is booked for the private NIC, or if IPAM is not available.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getInstancePrivateNICIPs,
+				Transform:   transform.FromValue().Transform(transform.NullIfEmptySliceValue),
+			},
+			{
+				Name:        "tags",
+				Description: "A list of tags associated with the private NIC.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "zone",
+				Description: "Specifies the zone where the private NIC resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
+			},
+			{
+				Name:        "project",
+				Description: "The ID of the project where the private NIC resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization",
+				Description: "The ID of the organization where the private NIC resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+		},
+	}
+}
+
+type privateNICInfo = struct {
+	instance.PrivateNIC
+	ServerName   string
+	Zone         scw.Zone
+	Project      string
+	Organization string
+}
+
+//// LIST FUNCTION
+
+func listInstancePrivateNICs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	zone := d.EqualsQualString("zone")
+
+	parseZoneData, err := scw.ParseZone(zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_private_nic.listInstancePrivateNICs", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	if quals["zone"] != nil && quals["zone"].GetStringValue() != zone {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_private_nic.listInstancePrivateNICs", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	// The private NICs are listed with their server
+	req := &instance.ListServersRequest{
+		Zone: parseZoneData,
+		Page: scw.Int32Ptr(1),
+	}
+	// Additional filters
+	if quals["private_network_id"] != nil {
+		req.PrivateNetwork = scw.StringPtr(quals["private_network_id"].GetStringValue())
+	}
+	if quals["server_id"] != nil {
+		req.Servers = []string{quals["server_id"].GetStringValue()}
+	}
+	if quals["mac_address"] != nil {
+		req.PrivateNicMacAddress = scw.StringPtr(quals["mac_address"].GetStringValue())
+	}
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+
+	// Retrieve the list of servers
+	maxResult := int64(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
+
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_private_nic.listInstancePrivateNICs", "project_resolution_error", err)
+		return nil, err
+	}
+
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
+
+		for {
+			resp, err := instanceApi.ListServers(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_private_nic.listInstancePrivateNICs", "query_error", err)
+				return nil, err
+			}
+
+			for _, server := range resp.Servers {
+				// Increase the resource count by 1
+				count++
+
+				for _, privateNIC := range server.PrivateNics {
+					// A server may have NICs in other private networks
+					if quals["private_network_id"] != nil && quals["private_network_id"].GetStringValue() != privateNIC.PrivateNetworkID {
+						continue
+					}
+					if quals["mac_address"] != nil && quals["mac_address"].GetStringValue() != privateNIC.MacAddress {
+						continue
+					}
+
+					d.StreamListItem(ctx, privateNICInfo{*privateNIC, server.Name, server.Zone, server.Project, server.Organization})
+
+					// Context can be cancelled due to manual cancellation or the limit has been hit
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getInstancePrivateNICIPs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	privateNIC := h.Item.(privateNICInfo)
+
+	region, err := privateNIC.Zone.Region()
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_private_nic.getInstancePrivateNICIPs", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_private_nic.getInstancePrivateNICIPs", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway IPAM product
+	ipamApi := ipam.NewAPI(client)
+
+	resp, err := ipamApi.ListIPs(&ipam.ListIPsRequest{
+		Region:           region,
+		PrivateNetworkID: scw.StringPtr(privateNIC.PrivateNetworkID),
+		ResourceID:       scw.StringPtr(privateNIC.ID),
+		ResourceType:     ipam.ResourceTypeInstancePrivateNic,
+	}, scw.WithAllPages())
+	if err != nil {
+		// The IPs are not available, e.g. IPAM is denied to the credentials or not
+		// available in the region, the private NIC is still listed without them.
+		// Transient errors, such as 503, are retried and then returned
+		if is403Error(err) || is404Error(err) || isHTTPCodeError(err, http.StatusNotImplemented) {
+			plugin.Logger(ctx).Warn("scaleway_instance_private_nic.getInstancePrivateNICIPs", "ipam_unavailable", err)
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("scaleway_instance_private_nic.getInstancePrivateNICIPs", "query_error", err)
+		return nil, err
+	}
+
+	return resp.IPs, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ipamIPAddresses(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ips, ok := d.Value.([]*ipam.IP)
+	if !ok || len(ips) == 0 {
+		return nil, nil
+	}
+
+	addresses := make([]string, len(ips))
+	for i, ip := range ips {
+		addresses[i] = ip.Address.String()
+	}
+	return addresses, nil
+}
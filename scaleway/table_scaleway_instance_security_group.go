@@ -15,8 +15,9 @@ import (
 
 func tableScalewayInstanceSecurityGroup(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_instance_security_group",
-		Description:   "A security group is a set of firewall rules on a set of instances.",
+		Name:              "scaleway_instance_security_group",
+		Description:       "A security group is a set of firewall rules on a set of instances.",
+		Tags:              map[string]string{"service": "instance"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listInstanceSecurityGroups,
@@ -25,10 +26,27 @@ func tableScalewayInstanceSecurityGroup(_ context.Context) *plugin.Table {
 					Name:    "name",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "project_default",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "tags",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
 				{
 					Name:    "zone",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -96,8 +114,14 @@ func tableScalewayInstanceSecurityGroup(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "servers",
+				Description: "A list of servers attached to the security group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags",
 				Description: "A list of tags associated with the security group.",
 				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
 			},
 
 			// Scaleway standard columns
@@ -163,6 +187,16 @@ func listInstanceSecurityGroups(ctx context.Context, d *plugin.QueryData, _ *plu
 	if quals["name"] != nil {
 		req.Name = scw.StringPtr(quals["name"].GetStringValue())
 	}
+	if d.EqualsQuals["project_default"] != nil {
+		req.ProjectDefault = scw.BoolPtr(d.EqualsQuals["project_default"].GetBoolValue())
+	}
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+	// The API returns the security groups having all the tags
+	if tags := getQualsExistsValues(d, "tags"); len(tags) > 0 {
+		req.Tags = tags
+	}
 
 	// Retrieve the list of security groups
 	maxResult := int64(100)
@@ -176,31 +210,43 @@ func listInstanceSecurityGroups(ctx context.Context, d *plugin.QueryData, _ *plu
 	}
 	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_security_group.listInstanceSecurityGroups", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := instanceApi.ListSecurityGroups(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_instance_security_group.listInstanceSecurityGroups", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
+
+		for {
+			resp, err := instanceApi.ListSecurityGroups(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_security_group.listInstanceSecurityGroups", "query_error", err)
+				return nil, err
+			}
 
-		for _, securityGroup := range resp.SecurityGroups {
-			d.StreamListItem(ctx, securityGroup)
+			for _, securityGroup := range resp.SecurityGroups {
+				d.StreamListItem(ctx, securityGroup)
 
-			// Increase the resource count by 1
-			count++
+				// Increase the resource count by 1
+				count++
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
@@ -0,0 +1,230 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayInstanceSecurityGroupRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_security_group_rule",
+		Description:       "A security group rule allows or drops the traffic matching a protocol, direction, IP range and port range.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate:       listInstanceSecurityGroupRules,
+			ParentHydrate: listInstanceSecurityGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "security_group_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "zone",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "An unique identifier of the security group rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "security_group_id",
+				Description: "The ID of the security group the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityGroupID"),
+			},
+			{
+				Name:        "security_group_name",
+				Description: "The name of the security group the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol of the traffic matched by the rule (TCP, UDP, ICMP or ANY).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Protocol").Transform(transform.ToString),
+			},
+			{
+				Name:        "direction",
+				Description: "The direction of the traffic matched by the rule (inbound or outbound).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Direction").Transform(transform.ToString),
+			},
+			{
+				Name:        "action",
+				Description: "The action applied to the traffic matched by the rule (accept or drop).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Action").Transform(transform.ToString),
+			},
+			{
+				Name:        "ip_range",
+				Description: "The IP range of the traffic matched by the rule.",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("IPRange").Transform(ipNetToString),
+			},
+			{
+				Name:        "dest_port_from",
+				Description: "The first destination port matched by the rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DestPortFrom"),
+			},
+			{
+				Name:        "dest_port_to",
+				Description: "The last destination port matched by the rule, null if the rule matches a single port.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DestPortTo"),
+			},
+			{
+				Name:        "position",
+				Description: "The position of the rule in the security group, rules are evaluated in ascending order.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Position"),
+			},
+			{
+				Name:        "editable",
+				Description: "Indicates whether the rule can be edited, or not. Default rules are not editable.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Editable"),
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "zone",
+				Description: "Specifies the zone where the security group rule resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
+			},
+			{
+				Name:        "project",
+				Description: "The ID of the project where the security group rule resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization",
+				Description: "The ID of the organization where the security group rule resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+		},
+	}
+}
+
+type securityGroupRuleInfo = struct {
+	instance.SecurityGroupRule
+	SecurityGroupID   string
+	SecurityGroupName string
+	Project           string
+	Organization      string
+}
+
+//// LIST FUNCTION
+
+func listInstanceSecurityGroupRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	zone := d.EqualsQualString("zone")
+
+	parseZoneData, err := scw.ParseZone(zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_security_group_rule.listInstanceSecurityGroupRules", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	// Get security group details
+	securityGroupData := h.Item.(*instance.SecurityGroup)
+
+	quals := d.EqualsQuals
+	if quals["zone"] != nil && quals["zone"].GetStringValue() != zone {
+		return nil, nil
+	}
+
+	// Skip the rules of the other security groups
+	if quals["security_group_id"] != nil && quals["security_group_id"].GetStringValue() != securityGroupData.ID {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_security_group_rule.listInstanceSecurityGroupRules", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	req := &instance.ListSecurityGroupRulesRequest{
+		Zone:            parseZoneData,
+		SecurityGroupID: securityGroupData.ID,
+		Page:            scw.Int32Ptr(1),
+	}
+
+	// Retrieve the list of security group rules
+	maxResult := int64(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
+
+	var count int
+
+	for {
+		resp, err := instanceApi.ListSecurityGroupRules(req)
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_instance_security_group_rule.listInstanceSecurityGroupRules", "query_error", err)
+			return nil, err
+		}
+
+		for _, rule := range resp.Rules {
+			d.StreamListItem(ctx, securityGroupRuleInfo{*rule, securityGroupData.ID, securityGroupData.Name, securityGroupData.Project, securityGroupData.Organization})
+
+			// Increase the resource count by 1
+			count++
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.TotalCount == uint32(count) {
+			break
+		}
+		req.Page = scw.Int32Ptr(*req.Page + 1)
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ipNetToString(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ipNet, ok := d.Value.(scw.IPNet)
+	if !ok || ipNet.IP == nil {
+		return nil, nil
+	}
+	return ipNet.String(), nil
+}
@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"net"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 
@@ -15,8 +16,9 @@ import (
 
 func tableScalewayInstanceServer(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_instance_server",
-		Description:   "A Compute Instance server is a virtual server in Scaleway.",
+		Name:              "scaleway_instance_server",
+		Description:       "A Compute Instance server is a virtual server in Scaleway.",
+		Tags:              map[string]string{"service": "instance"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listInstanceServers,
@@ -29,10 +31,36 @@ func tableScalewayInstanceServer(_ context.Context) *plugin.Table {
 					Name:    "commercial_type",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "private_ip",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "private_network_ids",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
+				{
+					Name:      "tags",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
 				{
 					Name:    "zone",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -106,6 +134,7 @@ func tableScalewayInstanceServer(_ context.Context) *plugin.Table {
 				Name:        "private_ip",
 				Description: "The private IP address of the server.",
 				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("PrivateIP"),
 			},
 			{
 				Name:        "modification_date",
@@ -175,6 +204,12 @@ func tableScalewayInstanceServer(_ context.Context) *plugin.Table {
 				Description: "The server private NICs.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "private_network_ids",
+				Description: "The IDs of the private networks the server is attached to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateNics").Transform(privateNICsNetworkIDs),
+			},
 			{
 				Name:        "tags",
 				Description: "A list of tags associated with the server.",
@@ -247,6 +282,26 @@ func listInstanceServers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	if quals["commercial_type"] != nil {
 		req.CommercialType = scw.StringPtr(quals["commercial_type"].GetStringValue())
 	}
+	if quals["state"] != nil {
+		state := instance.ServerState(quals["state"].GetStringValue())
+		req.State = &state
+	}
+	if quals["private_ip"] != nil {
+		if privateIP := net.ParseIP(quals["private_ip"].GetInetValue().GetAddr()); privateIP != nil {
+			req.PrivateIP = &privateIP
+		}
+	}
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+	// The API returns the servers having all the tags
+	if tags := getQualsExistsValues(d, "tags"); len(tags) > 0 {
+		req.Tags = tags
+	}
+	// The API filters on a single private network
+	if privateNetworkIDs := getQualsExistsValues(d, "private_network_ids"); len(privateNetworkIDs) > 0 {
+		req.PrivateNetwork = scw.StringPtr(privateNetworkIDs[0])
+	}
 
 	// Retrieve the list of servers
 	maxResult := int64(100)
@@ -260,31 +315,43 @@ func listInstanceServers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	}
 	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server.listInstanceServers", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := instanceApi.ListServers(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_instance_server.listInstanceServers", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
+
+		for {
+			resp, err := instanceApi.ListServers(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_server.listInstanceServers", "query_error", err)
+				return nil, err
+			}
 
-		for _, instance := range resp.Servers {
-			d.StreamListItem(ctx, instance)
+			for _, instance := range resp.Servers {
+				d.StreamListItem(ctx, instance)
 
-			// Increase the resource count by 1
-			count++
+				// Increase the resource count by 1
+				count++
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
@@ -297,7 +364,7 @@ func getInstanceServer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 	parseZoneData, err := scw.ParseZone(zone)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_instance_server.listInstanceServers", "zone_parsing_error", err)
+		plugin.Logger(ctx).Error("scaleway_instance_server.getInstanceServer", "zone_parsing_error", err)
 		return nil, err
 	}
 
@@ -337,3 +404,18 @@ func getInstanceServer(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 	return data.Server, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func privateNICsNetworkIDs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	privateNICs, ok := d.Value.([]*instance.PrivateNIC)
+	if !ok {
+		return nil, nil
+	}
+
+	ids := make([]string, len(privateNICs))
+	for i, privateNIC := range privateNICs {
+		ids[i] = privateNIC.PrivateNetworkID
+	}
+	return ids, nil
+}
@@ -0,0 +1,32 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// instanceServerCPUMetrics are the CPU metrics of the instance servers
+var instanceServerCPUMetrics = []instanceServerMetric{
+	{
+		Name:  "cpu_usage",
+		Unit:  "cores",
+		Query: `sum by (resource_id) (rate(instance_server_cpu_seconds_total{%s}[%s]))`,
+	},
+}
+
+//// TABLE DEFINITION
+
+func tableScalewayInstanceServerMetricCPU(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_server_metric_cpu",
+		Description:       "The CPU usage of the instance servers, aggregated by period from the Cockpit metrics.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate:    listInstanceServerMetrics(instanceServerCPUMetrics),
+			KeyColumns: instanceServerMetricKeyColumns(),
+		},
+		Columns: instanceServerMetricColumns(),
+	}
+}
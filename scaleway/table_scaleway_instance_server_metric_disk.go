@@ -0,0 +1,37 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// instanceServerDiskMetrics are the disk metrics of the instance servers
+var instanceServerDiskMetrics = []instanceServerMetric{
+	{
+		Name:  "disk_read",
+		Unit:  "bytes/s",
+		Query: `sum by (resource_id) (rate(instance_server_disk_read_bytes_total{%s}[%s]))`,
+	},
+	{
+		Name:  "disk_written",
+		Unit:  "bytes/s",
+		Query: `sum by (resource_id) (rate(instance_server_disk_write_bytes_total{%s}[%s]))`,
+	},
+}
+
+//// TABLE DEFINITION
+
+func tableScalewayInstanceServerMetricDisk(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_server_metric_disk",
+		Description:       "The disk I/O of the instance servers, aggregated by period from the Cockpit metrics.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate:    listInstanceServerMetrics(instanceServerDiskMetrics),
+			KeyColumns: instanceServerMetricKeyColumns(),
+		},
+		Columns: instanceServerMetricColumns(),
+	}
+}
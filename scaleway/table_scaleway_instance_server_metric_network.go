@@ -0,0 +1,37 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// instanceServerNetworkMetrics are the network metrics of the instance servers
+var instanceServerNetworkMetrics = []instanceServerMetric{
+	{
+		Name:  "network_received",
+		Unit:  "bytes/s",
+		Query: `sum by (resource_id) (rate(instance_server_network_receive_bytes_total{%s}[%s]))`,
+	},
+	{
+		Name:  "network_transmitted",
+		Unit:  "bytes/s",
+		Query: `sum by (resource_id) (rate(instance_server_network_transmit_bytes_total{%s}[%s]))`,
+	},
+}
+
+//// TABLE DEFINITION
+
+func tableScalewayInstanceServerMetricNetwork(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_server_metric_network",
+		Description:       "The network traffic of the instance servers, aggregated by period from the Cockpit metrics.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate:    listInstanceServerMetrics(instanceServerNetworkMetrics),
+			KeyColumns: instanceServerMetricKeyColumns(),
+		},
+		Columns: instanceServerMetricColumns(),
+	}
+}
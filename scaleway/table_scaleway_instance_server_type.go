@@ -0,0 +1,278 @@
+package scaleway
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayInstanceServerType(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_server_type",
+		Description:       "A server type is a commercial type of Instance, with its price, resources and availability in a zone.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate: listInstanceServerTypes,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "zone",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the server type, i.e. the commercial type of the servers.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alt_names",
+				Description: "The alternative names of the server type.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AltNames"),
+			},
+			{
+				Name:        "availability",
+				Description: "The availability of the server type in the zone (available, scarce or shortage).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Availability").Transform(transform.ToString).Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "hourly_price",
+				Description: "The hourly price of a server of this type, in euros.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("HourlyPrice").Transform(float32ToFloat64),
+			},
+			{
+				Name:        "monthly_price",
+				Description: "The estimated monthly price of a server of this type, for a 30 days month, in euros.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("MonthlyPrice").Transform(float32ToFloat64),
+			},
+			{
+				Name:        "arch",
+				Description: "The CPU architecture of the server type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arch").Transform(transform.ToString),
+			},
+			{
+				Name:        "ncpus",
+				Description: "The number of CPUs of the server type.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Ncpus"),
+			},
+			{
+				Name:        "ram",
+				Description: "The available RAM of the server type (in bytes).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RAM"),
+			},
+			{
+				Name:        "gpu",
+				Description: "The number of GPUs of the server type.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Gpu"),
+			},
+			{
+				Name:        "baremetal",
+				Description: "Indicates whether the server type is a baremetal Instance, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Baremetal"),
+			},
+			{
+				Name:        "block_storage",
+				Description: "Indicates whether the server type supports block storage, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Capabilities.BlockStorage"),
+			},
+			{
+				Name:        "boot_types",
+				Description: "The boot types supported by the server type.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Capabilities.BootTypes"),
+			},
+			{
+				Name:        "volumes_min_size",
+				Description: "The minimum total size of the local volumes of a server of this type (in bytes).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("VolumesConstraint.MinSize").Transform(transform.ToInt),
+			},
+			{
+				Name:        "volumes_max_size",
+				Description: "The maximum total size of the local volumes of a server of this type (in bytes).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("VolumesConstraint.MaxSize").Transform(transform.ToInt),
+			},
+			{
+				Name:        "l_ssd_volume_min_size",
+				Description: "The minimum size of each local SSD volume of a server of this type (in bytes).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PerVolumeConstraint.LSSD.MinSize").Transform(transform.ToInt),
+			},
+			{
+				Name:        "l_ssd_volume_max_size",
+				Description: "The maximum size of each local SSD volume of a server of this type (in bytes).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PerVolumeConstraint.LSSD.MaxSize").Transform(transform.ToInt),
+			},
+			{
+				Name:        "scratch_storage_max_size",
+				Description: "The maximum size of the scratch storage of a server of this type (in bytes).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ScratchStorageMaxSize").Transform(transform.ToInt),
+			},
+			{
+				Name:        "internet_bandwidth",
+				Description: "The maximum internet bandwidth of a server of this type (in bits per second).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Network.SumInternetBandwidth"),
+			},
+			{
+				Name:        "internal_bandwidth",
+				Description: "The maximum internal bandwidth of a server of this type (in bits per second).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Network.SumInternalBandwidth"),
+			},
+			{
+				Name:        "ipv6_support",
+				Description: "Indicates whether IPv6 is supported by the server type, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Network.IPv6Support"),
+			},
+			{
+				Name:        "network_interfaces",
+				Description: "The network interfaces of the server type, with their internal and internet bandwidth.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Network.Interfaces"),
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "zone",
+				Description: "Specifies the zone where the server type is offered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+type serverTypeInfo = struct {
+	instance.ServerType
+	Name         string
+	Availability instance.ServerTypesAvailability
+	Zone         scw.Zone
+}
+
+//// LIST FUNCTION
+
+func listInstanceServerTypes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	zone := d.EqualsQualString("zone")
+
+	parseZoneData, err := scw.ParseZone(zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server_type.listInstanceServerTypes", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	if quals["zone"] != nil && quals["zone"].GetStringValue() != zone {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server_type.listInstanceServerTypes", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	// The server types are returned as a map indexed by name, so all the pages are retrieved
+	resp, err := instanceApi.ListServersTypes(&instance.ListServersTypesRequest{
+		Zone: parseZoneData,
+	}, scw.WithAllPages())
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server_type.listInstanceServerTypes", "query_error", err)
+		return nil, err
+	}
+
+	availabilityResp, err := instanceApi.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+		Zone: parseZoneData,
+	}, scw.WithAllPages())
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server_type.listInstanceServerTypes", "availability_query_error", err)
+		return nil, err
+	}
+
+	names := make([]string, 0, len(resp.Servers))
+	for name, serverType := range resp.Servers {
+		if serverType == nil {
+			continue
+		}
+		if quals["name"] != nil && quals["name"].GetStringValue() != name {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		item := serverTypeInfo{ServerType: *resp.Servers[name], Name: name, Zone: parseZoneData}
+		if availability, ok := availabilityResp.Servers[name]; ok && availability != nil {
+			item.Availability = availability.Availability
+		}
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// float32ToFloat64 converts the prices to float64 without the float32 rounding noise, e.g. 0.0088 instead of 0.008799999952316284
+func float32ToFloat64(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var value float32
+	switch v := d.Value.(type) {
+	case float32:
+		value = v
+	case *float32:
+		if v == nil {
+			return nil, nil
+		}
+		value = *v
+	default:
+		return d.Value, nil
+	}
+	return strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+}
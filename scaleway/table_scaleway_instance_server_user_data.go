@@ -0,0 +1,283 @@
+package scaleway
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"unicode/utf8"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// serverUserDataMaxSize is the maximum number of bytes of a user data value
+// returned by the table, larger values are truncated
+const serverUserDataMaxSize = 64 * 1024
+
+//// TABLE DEFINITION
+
+func tableScalewayInstanceServerUserData(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_instance_server_user_data",
+		Description:       "The user data of a server are key/value pairs, such as the cloud-init script, made available to the server through the metadata API.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate:       listInstanceServerUserData,
+			ParentHydrate: listInstanceServers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "server_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "key",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "zone",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "server_id",
+				Description: "The ID of the server the user data belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerID"),
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the user data belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the user data, e.g. cloud-init.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the user data, truncated to 64 KiB. Binary values, such as gzipped scripts, are base64 encoded.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getInstanceServerUserDataValue,
+				Transform:   transform.FromField("Value"),
+			},
+			{
+				Name:        "encoding",
+				Description: "The encoding of the value, text or base64 for the binary values, i.e. not valid UTF-8 or containing NUL bytes.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getInstanceServerUserDataValue,
+				Transform:   transform.FromField("Encoding"),
+			},
+			{
+				Name:        "size",
+				Description: "The size of the user data value in bytes, before truncation.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getInstanceServerUserDataValue,
+				Transform:   transform.FromField("Size"),
+			},
+			{
+				Name:        "truncated",
+				Description: "Indicates whether the value has been truncated because it is larger than 64 KiB, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getInstanceServerUserDataValue,
+				Transform:   transform.FromField("Truncated"),
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "zone",
+				Description: "Specifies the zone where the server resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
+			},
+			{
+				Name:        "project",
+				Description: "The ID of the project where the server resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization",
+				Description: "The ID of the organization where the server resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		},
+	}
+}
+
+type serverUserDataInfo = struct {
+	Key          string
+	ServerID     string
+	ServerName   string
+	Zone         scw.Zone
+	Project      string
+	Organization string
+}
+
+type serverUserDataValue = struct {
+	Value     string
+	Encoding  string
+	Size      int64
+	Truncated bool
+}
+
+const (
+	serverUserDataEncodingText   = "text"
+	serverUserDataEncodingBase64 = "base64"
+)
+
+//// LIST FUNCTION
+
+func listInstanceServerUserData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get server details
+	server := h.Item.(*instance.Server)
+
+	quals := d.EqualsQuals
+	if quals["zone"] != nil && quals["zone"].GetStringValue() != server.Zone.String() {
+		return nil, nil
+	}
+
+	// Skip the user data of the other servers
+	if quals["server_id"] != nil && quals["server_id"].GetStringValue() != server.ID {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server_user_data.listInstanceServerUserData", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	resp, err := instanceApi.ListServerUserData(&instance.ListServerUserDataRequest{
+		ServerID: server.ID,
+		Zone:     server.Zone,
+	})
+	if err != nil {
+		// The server may have been deleted since it was listed
+		if is404Error(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("scaleway_instance_server_user_data.listInstanceServerUserData", "query_error", err)
+		return nil, err
+	}
+
+	for _, key := range resp.UserData {
+		if quals["key"] != nil && quals["key"].GetStringValue() != key {
+			continue
+		}
+
+		d.StreamListItem(ctx, serverUserDataInfo{key, server.ID, server.Name, server.Zone, server.Project, server.Organization})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getInstanceServerUserDataValue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userData := h.Item.(serverUserDataInfo)
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server_user_data.getInstanceServerUserDataValue", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	reader, err := instanceApi.GetServerUserData(&instance.GetServerUserDataRequest{
+		ServerID: userData.ServerID,
+		Zone:     userData.Zone,
+		Key:      userData.Key,
+	})
+	if err != nil {
+		if is404Error(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("scaleway_instance_server_user_data.getInstanceServerUserDataValue", "query_error", err)
+		return nil, err
+	}
+
+	result, err := readServerUserData(reader, serverUserDataMaxSize)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_server_user_data.getInstanceServerUserDataValue", "read_error", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// readServerUserData reads a user data value truncated to maxSize bytes, as text,
+// or base64 encoded if it is binary
+func readServerUserData(reader io.Reader, maxSize int) (serverUserDataValue, error) {
+	// Read one byte more than the cap to know whether the value is truncated
+	data, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return serverUserDataValue{}, err
+	}
+	rest, err := io.Copy(io.Discard, reader)
+	if err != nil {
+		return serverUserDataValue{}, err
+	}
+
+	result := serverUserDataValue{Size: int64(len(data)) + rest}
+	if len(data) > maxSize {
+		data = data[:maxSize]
+		result.Truncated = true
+	}
+
+	// Postgres text columns can't hold NUL bytes nor invalid UTF-8, e.g. a gzipped
+	// cloud-init script, such values are base64 encoded rather than altered
+	text := data
+	if result.Truncated {
+		text = trimPartialRune(text)
+	}
+	if utf8.Valid(text) && bytes.IndexByte(text, 0) == -1 {
+		result.Value = string(text)
+		result.Encoding = serverUserDataEncodingText
+	} else {
+		result.Value = base64.StdEncoding.EncodeToString(data)
+		result.Encoding = serverUserDataEncodingBase64
+	}
+
+	return result, nil
+}
+
+// trimPartialRune removes the last rune of a truncated text if the truncation split it
+func trimPartialRune(data []byte) []byte {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return data[:i]
+			}
+			break
+		}
+	}
+	return data
+}
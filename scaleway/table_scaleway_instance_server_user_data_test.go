@@ -0,0 +1,48 @@
+package scaleway
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadServerUserData(t *testing.T) {
+	got, err := readServerUserData(strings.NewReader("#cloud-config\n"), 16)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Value != "#cloud-config\n" || got.Encoding != "text" || got.Size != 14 || got.Truncated {
+		t.Errorf("unexpected value: %+v", got)
+	}
+
+	// The truncation cuts the last rune, which is dropped
+	got, err = readServerUserData(strings.NewReader("password: é123"), 11)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Value != "password: " || got.Encoding != "text" || got.Size != 15 || !got.Truncated {
+		t.Errorf("unexpected truncated value: %+v", got)
+	}
+
+	// Binary values, e.g. gzipped or with NUL bytes, are base64 encoded
+	for _, value := range []string{"\x1f\x8b\x08\x00", "#cloud-config\x00\n"} {
+		got, err = readServerUserData(strings.NewReader(value), 16)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Encoding != "base64" || got.Size != int64(len(value)) || got.Truncated {
+			t.Errorf("unexpected binary value: %+v", got)
+		}
+	}
+	if got.Value != "I2Nsb3VkLWNvbmZpZwAK" {
+		t.Errorf("unexpected base64 value: %s", got.Value)
+	}
+
+	// An invalid sequence before the truncation is not mistaken for a cut rune
+	got, err = readServerUserData(strings.NewReader("ab\xffcd"), 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Encoding != "base64" || got.Value != "YWL/Yw==" || !got.Truncated {
+		t.Errorf("unexpected truncated binary value: %+v", got)
+	}
+}
@@ -2,6 +2,8 @@ package scaleway
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 
@@ -15,8 +17,9 @@ import (
 
 func tableScalewayInstanceSnapshot(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_instance_snapshot",
-		Description:   "Snapshots contain the data of a specific volume at a particular point in time.",
+		Name:              "scaleway_instance_snapshot",
+		Description:       "Snapshots contain the data of a specific volume at a particular point in time.",
+		Tags:              map[string]string{"service": "instance"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listInstanceSnapshots,
@@ -25,10 +28,27 @@ func tableScalewayInstanceSnapshot(_ context.Context) *plugin.Table {
 					Name:    "name",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "base_volume_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "tags",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
 				{
 					Name:    "zone",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -79,6 +99,33 @@ func tableScalewayInstanceSnapshot(_ context.Context) *plugin.Table {
 				Name:        "snapshot_base_volume",
 				Description: "Specifies the volume on which the snapshot is based on.",
 				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BaseVolume"),
+			},
+			{
+				Name:        "base_volume_id",
+				Description: "The ID of the volume the snapshot was taken from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BaseVolume.ID"),
+			},
+			{
+				Name:        "image_ids",
+				Description: "The IDs of the images using the snapshot as root or extra volume.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getInstanceSnapshotLineage,
+				Transform:   transform.FromField("ImageIDs"),
+			},
+			{
+				Name:        "orphaned",
+				Description: "True if the snapshot isn't used by any image.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getInstanceSnapshotLineage,
+				Transform:   transform.FromField("Orphaned"),
+			},
+			{
+				Name:        "tags",
+				Description: "A list of tags associated with the snapshot.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
 			},
 
 			// Scaleway standard columns
@@ -110,6 +157,11 @@ func tableScalewayInstanceSnapshot(_ context.Context) *plugin.Table {
 	}
 }
 
+type snapshotLineage = struct {
+	ImageIDs []string
+	Orphaned bool
+}
+
 //// LIST FUNCTION
 
 func listInstanceSnapshots(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -144,6 +196,16 @@ func listInstanceSnapshots(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	if quals["name"] != nil {
 		req.Name = scw.StringPtr(quals["name"].GetStringValue())
 	}
+	if quals["base_volume_id"] != nil {
+		req.BaseVolumeID = scw.StringPtr(quals["base_volume_id"].GetStringValue())
+	}
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+	// The API returns the snapshots having all the tags
+	if tags := getQualsExistsValues(d, "tags"); len(tags) > 0 {
+		req.Tags = scw.StringPtr(strings.Join(tags, ","))
+	}
 
 	// Retrieve the list of snapshots
 	maxResult := int64(100)
@@ -157,32 +219,44 @@ func listInstanceSnapshots(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	}
 	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_snapshot.listInstanceSnapshots", "project_resolution_error", err)
+		return nil, err
+	}
+
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
 
-	for {
-		resp, err := instanceApi.ListSnapshots(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_instance_snapshot.listInstanceSnapshots", "query_error", err)
-			return nil, err
-		}
+		var count int
+
+		for {
+			resp, err := instanceApi.ListSnapshots(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_snapshot.listInstanceSnapshots", "query_error", err)
+				return nil, err
+			}
 
-		for _, snapshot := range resp.Snapshots {
-			d.StreamListItem(ctx, snapshot)
+			for _, snapshot := range resp.Snapshots {
+				d.StreamListItem(ctx, snapshot)
 
-			// Increase the resource count by 1
-			count++
+				// Increase the resource count by 1
+				count++
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
-		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 
+		}
 	}
 
 	return nil, nil
@@ -235,3 +309,71 @@ func getInstanceSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 	return data.Snapshot, nil
 }
+
+func getInstanceSnapshotLineage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	snapshot := h.Item.(*instance.Snapshot)
+
+	imageIDs, err := listInstanceSnapshotImageIDs(ctx, d, &plugin.HydrateData{Item: snapshot.Zone})
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_snapshot.getInstanceSnapshotLineage", "query_error", err)
+		return nil, err
+	}
+
+	ids := imageIDs.(map[string][]string)[snapshot.ID]
+	return snapshotLineage{ImageIDs: ids, Orphaned: len(ids) == 0}, nil
+}
+
+// listInstanceSnapshotImageIDs :: returns the IDs of the images using each snapshot of a zone,
+// by snapshot ID. The images are listed once per zone for all the rows of the query.
+var listInstanceSnapshotImageIDs = plugin.HydrateFunc(listInstanceSnapshotImageIDsUncached).Memoize(memoizeByZone("scaleway_instance_snapshot.image_ids"))
+
+func listInstanceSnapshotImageIDsUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	zone := h.Item.(scw.Zone)
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_snapshot.listInstanceSnapshotImageIDsUncached", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_snapshot.listInstanceSnapshotImageIDsUncached", "project_resolution_error", err)
+		return nil, err
+	}
+
+	imageIDs := map[string][]string{}
+	for _, projectID := range projectIDs {
+		// The public images are built from the snapshots of Scaleway, not of the projects
+		resp, err := instanceApi.ListImages(&instance.ListImagesRequest{
+			Zone:    zone,
+			Project: projectID,
+			Public:  scw.BoolPtr(false),
+		}, scw.WithAllPages())
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_instance_snapshot.listInstanceSnapshotImageIDsUncached", "query_error", err)
+			return nil, err
+		}
+
+		for _, image := range resp.Images {
+			// The volumes of an image are snapshots
+			if image.RootVolume != nil {
+				imageIDs[image.RootVolume.ID] = append(imageIDs[image.RootVolume.ID], image.ID)
+			}
+			for _, volume := range image.ExtraVolumes {
+				if volume != nil {
+					imageIDs[volume.ID] = append(imageIDs[volume.ID], image.ID)
+				}
+			}
+		}
+	}
+
+	for _, ids := range imageIDs {
+		sort.Strings(ids)
+	}
+	return imageIDs, nil
+}
@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"sort"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 
@@ -15,8 +16,9 @@ import (
 
 func tableScalewayInstanceVolume(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_instance_volume",
-		Description:   "A volume is where you store your data inside your instance.",
+		Name:              "scaleway_instance_volume",
+		Description:       "A volume is where you store your data inside your instance.",
+		Tags:              map[string]string{"service": "instance"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listInstanceVolumes,
@@ -25,10 +27,27 @@ func tableScalewayInstanceVolume(_ context.Context) *plugin.Table {
 					Name:    "name",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "volume_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "tags",
+					Require:   plugin.Optional,
+					Operators: []string{"?"},
+				},
 				{
 					Name:    "zone",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "project",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -86,6 +105,25 @@ func tableScalewayInstanceVolume(_ context.Context) *plugin.Table {
 				Description: "Specifies the server attached to the volume.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "snapshot_ids",
+				Description: "The IDs of the snapshots taken from the volume.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getInstanceVolumeSnapshotIDs,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "orphaned",
+				Description: "True if the volume isn't attached to any server.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(instanceVolumeOrphaned),
+			},
+			{
+				Name:        "tags",
+				Description: "A list of tags associated with the volume.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
 
 			// Scaleway standard columns
 			{
@@ -150,6 +188,17 @@ func listInstanceVolumes(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	if quals["name"] != nil {
 		req.Name = scw.StringPtr(quals["name"].GetStringValue())
 	}
+	if quals["volume_type"] != nil {
+		volumeType := instance.VolumeVolumeType(quals["volume_type"].GetStringValue())
+		req.VolumeType = &volumeType
+	}
+	if quals["organization"] != nil {
+		req.Organization = scw.StringPtr(quals["organization"].GetStringValue())
+	}
+	// The API returns the volumes having all the tags
+	if tags := getQualsExistsValues(d, "tags"); len(tags) > 0 {
+		req.Tags = tags
+	}
 
 	// Retrieve the list of volumes
 	maxResult := int64(100)
@@ -163,32 +212,44 @@ func listInstanceVolumes(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	}
 	req.PerPage = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getQualProjectIDs(ctx, d, "project")
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_volume.listInstanceVolumes", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := instanceApi.ListVolumes(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_instance_volume.listInstanceVolumes", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.Project = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
 
-		for _, volume := range resp.Volumes {
-			d.StreamListItem(ctx, volume)
+		for {
+			resp, err := instanceApi.ListVolumes(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_instance_volume.listInstanceVolumes", "query_error", err)
+				return nil, err
+			}
 
-			// Increase the resource count by 1
-			count++
+			for _, volume := range resp.Volumes {
+				d.StreamListItem(ctx, volume)
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Increase the resource count by 1
+				count++
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
-		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 
+		}
 	}
 
 	return nil, nil
@@ -241,3 +302,69 @@ func getInstanceVolume(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 	return data.Volume, nil
 }
+
+func getInstanceVolumeSnapshotIDs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	volume := h.Item.(*instance.Volume)
+
+	snapshotIDs, err := listInstanceVolumeSnapshotIDs(ctx, d, &plugin.HydrateData{Item: volume.Zone})
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_volume.getInstanceVolumeSnapshotIDs", "query_error", err)
+		return nil, err
+	}
+
+	return snapshotIDs.(map[string][]string)[volume.ID], nil
+}
+
+// listInstanceVolumeSnapshotIDs :: returns the IDs of the snapshots taken from each volume of a zone,
+// by volume ID. The snapshots are listed once per zone for all the rows of the query.
+var listInstanceVolumeSnapshotIDs = plugin.HydrateFunc(listInstanceVolumeSnapshotIDsUncached).Memoize(memoizeByZone("scaleway_instance_volume.snapshot_ids"))
+
+func listInstanceVolumeSnapshotIDsUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	zone := h.Item.(scw.Zone)
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_volume.listInstanceVolumeSnapshotIDsUncached", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Instance product
+	instanceApi := instance.NewAPI(client)
+
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_instance_volume.listInstanceVolumeSnapshotIDsUncached", "project_resolution_error", err)
+		return nil, err
+	}
+
+	snapshotIDs := map[string][]string{}
+	for _, projectID := range projectIDs {
+		resp, err := instanceApi.ListSnapshots(&instance.ListSnapshotsRequest{
+			Zone:    zone,
+			Project: projectID,
+		}, scw.WithAllPages())
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_instance_volume.listInstanceVolumeSnapshotIDsUncached", "query_error", err)
+			return nil, err
+		}
+
+		for _, snapshot := range resp.Snapshots {
+			if snapshot.BaseVolume != nil {
+				snapshotIDs[snapshot.BaseVolume.ID] = append(snapshotIDs[snapshot.BaseVolume.ID], snapshot.ID)
+			}
+		}
+	}
+
+	for _, ids := range snapshotIDs {
+		sort.Strings(ids)
+	}
+	return snapshotIDs, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func instanceVolumeOrphaned(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	volume := d.HydrateItem.(*instance.Volume)
+	return volume.Server == nil, nil
+}
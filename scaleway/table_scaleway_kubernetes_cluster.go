@@ -17,6 +17,7 @@ func tableScalewayKubernetesCluster(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:              "scaleway_kubernetes_cluster",
 		Description:       "Kubernetes Clusters allow you to manage your Container Kubernetes in Scaleway.",
+		Tags:              map[string]string{"service": "k8s"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate: listKubernetesClusters,
@@ -101,7 +102,7 @@ func tableScalewayKubernetesCluster(_ context.Context) *plugin.Table {
 				Name:        "dashboard_enabled",
 				Description: "The enablement of the Kubernetes Dashboard in the cluster.",
 				Type:        proto.ColumnType_BOOL,
-				Transform:   transform.FromField("DashboardEnabled").Transform(transform.ToBool),
+				Transform:   transform.FromField("DashboardEnabled"),
 			},
 			{
 				Name:        "auto_upgrade",
@@ -144,11 +145,13 @@ func tableScalewayKubernetesCluster(_ context.Context) *plugin.Table {
 				Name:        "project",
 				Description: "The ID of the project where the cluster resides.",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
 			},
 			{
 				Name:        "organization",
 				Description: "The ID of the organization where the cluster resides.",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrganizationID"),
 			},
 			{
 				Name:        "region",
@@ -166,7 +169,7 @@ func tableScalewayKubernetesCluster(_ context.Context) *plugin.Table {
 				Name:        "tags",
 				Description: "A list of tags associated with the cluster.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("Tags"),
 			},
 
 			// Steampipe standard columns
@@ -227,31 +230,43 @@ func listKubernetesClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_kubernetes_cluster.listKubernetesClusters", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := kubernetesApi.ListClusters(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_kubernetes_cluster.listKubernetesClusters", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.ProjectID = projectID
+		req.Page = scw.Int32Ptr(1)
 
-		for _, cluster := range resp.Clusters {
-			d.StreamListItem(ctx, cluster)
+		var count int
 
-			// Increase the resource count by 1
-			count++
+		for {
+			resp, err := kubernetesApi.ListClusters(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_kubernetes_cluster.listKubernetesClusters", "query_error", err)
+				return nil, err
+			}
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+			for _, cluster := range resp.Clusters {
+				d.StreamListItem(ctx, cluster)
+
+				// Increase the resource count by 1
+				count++
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
@@ -17,6 +17,7 @@ func tableScalewayKubernetesNode(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:              "scaleway_kubernetes_node",
 		Description:       "Kubernetes Nodes allow you to manage your Container Kubernetes in Scaleway.",
+		Tags:              map[string]string{"service": "k8s"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate:       listKubernetesNodes,
@@ -133,7 +134,7 @@ func tableScalewayKubernetesNode(_ context.Context) *plugin.Table {
 				Name:        "tags",
 				Description: "A list of tags associated with the node.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("Tags"),
 			},
 
 			// Steampipe standard columns
@@ -17,6 +17,7 @@ func tableScalewayKubernetesPool(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:              "scaleway_kubernetes_pool",
 		Description:       "Kubernetes Pools allow you to manage your Container Kubernetes in Scaleway.",
+		Tags:              map[string]string{"service": "k8s"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate:       listKubernetesPools,
@@ -150,7 +151,7 @@ func tableScalewayKubernetesPool(_ context.Context) *plugin.Table {
 				Name:        "zone",
 				Description: "Specifies the zone where the pool is located.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Region").Transform(transform.ToString),
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
 			},
 			{
 				Name:        "id",
@@ -162,7 +163,7 @@ func tableScalewayKubernetesPool(_ context.Context) *plugin.Table {
 				Name:        "tags",
 				Description: "A list of tags associated with the pool.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("Tags"),
 			},
 
 			// Steampipe standard columns
@@ -0,0 +1,253 @@
+package scaleway
+
+import (
+	"context"
+
+	marketplace "github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayMarketplaceImage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "scaleway_marketplace_image",
+		Description: "The public images of the Scaleway marketplace, such as the operating system distributions and the InstantApps.",
+		Tags:        map[string]string{"service": "marketplace"},
+		List: &plugin.ListConfig{
+			Hydrate: listMarketplaceImages,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getMarketplaceImage,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "An unique identifier of the image.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "label",
+				Description: "The label of the image, typically an identifier of the distribution, e.g. ubuntu_jammy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "categories",
+				Description: "The categories of the image, e.g. distribution or instantapp.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "logo",
+				Description: "The URL of the logo of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The time when the image was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time when the image was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_until",
+				Description: "The time when the image reaches its end of life. Null if the image is supported.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "local_images",
+				Description: "The local images of the current version of the image in the zones of the connection, with their ID, zone, architecture, type and compatible commercial types.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMarketplaceImageLocalImages,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listMarketplaceImages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_image.listMarketplaceImages", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Marketplace product
+	marketplaceApi := marketplace.NewAPI(client)
+
+	// The end-of-life images are listed too, the servers may still run them
+	req := &marketplace.ListImagesRequest{
+		Page:       scw.Int32Ptr(1),
+		IncludeEol: true,
+	}
+
+	// Retrieve the list of images
+	maxResult := int64(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
+
+	var count int
+
+	for {
+		resp, err := marketplaceApi.ListImages(req)
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_marketplace_image.listMarketplaceImages", "query_error", err)
+			return nil, err
+		}
+
+		for _, image := range resp.Images {
+			d.StreamListItem(ctx, image)
+
+			// Increase the resource count by 1
+			count++
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.TotalCount == uint32(count) {
+			break
+		}
+		req.Page = scw.Int32Ptr(*req.Page + 1)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMarketplaceImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_image.getMarketplaceImage", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Marketplace product
+	marketplaceApi := marketplace.NewAPI(client)
+
+	id := d.EqualsQualString("id")
+
+	// No inputs
+	if id == "" {
+		return nil, nil
+	}
+
+	data, err := marketplaceApi.GetImage(&marketplace.GetImageRequest{
+		ImageID: id,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_image.getMarketplaceImage", "query_error", err)
+		if is404Error(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func getMarketplaceImageLocalImages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	image := h.Item.(*marketplace.Image)
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_image.getMarketplaceImageLocalImages", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Marketplace product
+	marketplaceApi := marketplace.NewAPI(client)
+
+	localImages := []*marketplace.LocalImage{}
+	for _, matrixItem := range BuildZoneList(ctx, d) {
+		zone, err := scw.ParseZone(matrixItem["zone"].(string))
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_marketplace_image.getMarketplaceImageLocalImages", "zone_parsing_error", err)
+			return nil, err
+		}
+
+		resp, err := marketplaceApi.ListLocalImages(&marketplace.ListLocalImagesRequest{
+			ImageID: scw.StringPtr(image.ID),
+			Zone:    &zone,
+		}, scw.WithAllPages())
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_marketplace_image.getMarketplaceImageLocalImages", "query_error", err)
+			return nil, err
+		}
+		localImages = append(localImages, resp.LocalImages...)
+	}
+
+	return localImages, nil
+}
+
+// listMarketplaceImagesByID lists all the marketplace images by ID, once for the
+// zones of the local image listings.
+var listMarketplaceImagesByID = plugin.HydrateFunc(listMarketplaceImagesByIDUncached).Memoize(memoizeByName("scaleway_marketplace_image.images"))
+
+func listMarketplaceImagesByIDUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_image.listMarketplaceImagesByIDUncached", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Marketplace product
+	marketplaceApi := marketplace.NewAPI(client)
+
+	resp, err := marketplaceApi.ListImages(&marketplace.ListImagesRequest{
+		IncludeEol: true,
+	}, scw.WithAllPages())
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_image.listMarketplaceImagesByIDUncached", "query_error", err)
+		return nil, err
+	}
+
+	images := make(map[string]*marketplace.Image, len(resp.Images))
+	for _, image := range resp.Images {
+		images[image.ID] = image
+	}
+
+	return images, nil
+}
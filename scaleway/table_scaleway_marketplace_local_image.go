@@ -0,0 +1,197 @@
+package scaleway
+
+import (
+	"context"
+	"sort"
+
+	marketplace "github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayMarketplaceLocalImage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_marketplace_local_image",
+		Description:       "The local images of the marketplace images, the zone and architecture specific images the instance servers are created from.",
+		Tags:              map[string]string{"service": "marketplace"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate: listMarketplaceLocalImages,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "image_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "label",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "zone",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "An unique identifier of the local image, the ID of the image of the instance servers created from it.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "image_id",
+				Description: "The ID of the marketplace image of the local image.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImageID"),
+			},
+			{
+				Name:        "image_name",
+				Description: "The name of the marketplace image of the local image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "label",
+				Description: "The label of the marketplace image of the local image, e.g. ubuntu_jammy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arch",
+				Description: "The architecture the local image is compatible with. Possible values are 'x86_64' and 'arm64'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the local image, instance_local for the local volumes or instance_sbs for the block volumes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type").Transform(transform.ToString),
+			},
+			{
+				Name:        "compatible_commercial_types",
+				Description: "The commercial types of the instance servers the local image is compatible with.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "zone",
+				Description: "Specifies the zone where the local image is available.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImageName"),
+			},
+		},
+	}
+}
+
+type marketplaceLocalImageInfo = struct {
+	ID                        string
+	ImageID                   string
+	ImageName                 string
+	Label                     string
+	Arch                      string
+	Type                      marketplace.LocalImageType
+	CompatibleCommercialTypes []string
+	Zone                      scw.Zone
+}
+
+//// LIST FUNCTION
+
+func listMarketplaceLocalImages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	zone := d.EqualsQualString("zone")
+
+	parseZoneData, err := scw.ParseZone(zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_local_image.listMarketplaceLocalImages", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	if quals["zone"] != nil && quals["zone"].GetStringValue() != zone {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_local_image.listMarketplaceLocalImages", "connection_error", err)
+		return nil, err
+	}
+
+	// Create SDK objects for Scaleway Marketplace product
+	marketplaceApi := marketplace.NewAPI(client)
+
+	// The local images are listed by marketplace image, the images are listed
+	// once for all the zones
+	images, err := listMarketplaceImagesByID(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_marketplace_local_image.listMarketplaceLocalImages", "list_images_error", err)
+		return nil, err
+	}
+
+	var imageIDs []string
+	for id, image := range images.(map[string]*marketplace.Image) {
+		if quals["image_id"] != nil && quals["image_id"].GetStringValue() != id {
+			continue
+		}
+		if quals["label"] != nil && quals["label"].GetStringValue() != image.Label {
+			continue
+		}
+		imageIDs = append(imageIDs, id)
+	}
+	sort.Strings(imageIDs)
+
+	req := &marketplace.ListLocalImagesRequest{
+		Zone: &parseZoneData,
+	}
+	if quals["type"] != nil {
+		req.Type = marketplace.LocalImageType(quals["type"].GetStringValue())
+	}
+
+	for _, imageID := range imageIDs {
+		image := images.(map[string]*marketplace.Image)[imageID]
+		req.ImageID = scw.StringPtr(imageID)
+
+		resp, err := marketplaceApi.ListLocalImages(req, scw.WithAllPages())
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_marketplace_local_image.listMarketplaceLocalImages", "query_error", err)
+			return nil, err
+		}
+
+		for _, localImage := range resp.LocalImages {
+			d.StreamListItem(ctx, marketplaceLocalImageInfo{
+				ID:                        localImage.ID,
+				ImageID:                   image.ID,
+				ImageName:                 image.Name,
+				Label:                     localImage.Label,
+				Arch:                      localImage.Arch,
+				Type:                      localImage.Type,
+				CompatibleCommercialTypes: localImage.CompatibleCommercialTypes,
+				Zone:                      localImage.Zone,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -2,10 +2,15 @@ package scaleway
 
 import (
 	"context"
+	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -18,9 +23,64 @@ func tableScalewayObjectBucket(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:              "scaleway_object_bucket",
 		Description:       "A Scaleway Object bucket is a public cloud storage resource available in Scaleway, an object storage offering.",
+		Tags:              map[string]string{"service": "s3"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate: listObjectBuckets,
+			Tags:    map[string]string{"action": "ListBuckets"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getObjectBucket,
+			Tags:       map[string]string{"action": "HeadBucket"},
+			KeyColumns: plugin.SingleColumn("name"),
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getBucketIsPublic,
+				Tags: map[string]string{"action": "GetBucketPolicyStatus"},
+			},
+			{
+				Func: getBucketVersioning,
+				Tags: map[string]string{"action": "GetBucketVersioning"},
+			},
+			{
+				Func: getBucketCors,
+				Tags: map[string]string{"action": "GetBucketCors"},
+			},
+			{
+				Func: getBucketACL,
+				Tags: map[string]string{"action": "GetBucketAcl"},
+			},
+			{
+				Func: getBucketLifecycle,
+				Tags: map[string]string{"action": "GetBucketLifecycleConfiguration"},
+			},
+			{
+				Func: getBucketWebsite,
+				Tags: map[string]string{"action": "GetBucketWebsite"},
+			},
+			{
+				Func: getBucketPolicy,
+				Tags: map[string]string{"action": "GetBucketPolicy"},
+			},
+			{
+				Func: getBucketTagging,
+				Tags: map[string]string{"action": "GetBucketTagging"},
+			},
+			{
+				Func: getBucketObjectLockConfiguration,
+				Tags: map[string]string{"action": "GetObjectLockConfiguration"},
+			},
+			{
+				Func: getBucketEncryption,
+				Tags: map[string]string{"action": "GetBucketEncryption"},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -97,6 +157,42 @@ func tableScalewayObjectBucket(_ context.Context) *plugin.Table {
 				Hydrate:     getBucketTagging,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "object_lock_enabled",
+				Description: "Indicates whether the object lock is enabled on the bucket. The object lock can only be enabled when the bucket is created.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+				Hydrate:     getBucketObjectLockConfiguration,
+				Transform:   transform.FromField("ObjectLockEnabled").Transform(handleNilString).Transform(transform.ToBool),
+			},
+			{
+				Name:        "object_lock_mode",
+				Description: "The retention mode applied by default to the new objects of the bucket. Possible values are 'GOVERNANCE' and 'COMPLIANCE'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketObjectLockConfiguration,
+				Transform:   transform.FromField("Rule.DefaultRetention.Mode"),
+			},
+			{
+				Name:        "object_lock_retention_days",
+				Description: "The number of days the new objects of the bucket are retained by default.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketObjectLockConfiguration,
+				Transform:   transform.FromField("Rule.DefaultRetention.Days"),
+			},
+			{
+				Name:        "object_lock_retention_years",
+				Description: "The number of years the new objects of the bucket are retained by default.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getBucketObjectLockConfiguration,
+				Transform:   transform.FromField("Rule.DefaultRetention.Years"),
+			},
+			{
+				Name:        "server_side_encryption_configuration",
+				Description: "The default encryption configuration of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketEncryption,
+				Transform:   transform.FromField("ServerSideEncryptionConfiguration"),
+			},
 
 			// Scaleway standard columns
 			{
@@ -109,6 +205,11 @@ func tableScalewayObjectBucket(_ context.Context) *plugin.Table {
 				Description: "The ID of the project where the bucket resides.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "owner_display_name",
+				Description: "The display name of the owner of the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
 
 			// Steampipe standard columns
 			{
@@ -123,8 +224,9 @@ func tableScalewayObjectBucket(_ context.Context) *plugin.Table {
 
 type bucketInfo = struct {
 	s3.Bucket
-	Region  string
-	Project string
+	Region           string
+	Project          string
+	OwnerDisplayName string
 }
 
 //// LIST FUNCTION
@@ -145,9 +247,29 @@ func listObjectBuckets(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 		return nil, err
 	}
 
-	bucketOwner := strings.Split(*resp.Owner.ID, ":")[1]
+	bucketOwner, bucketOwnerDisplayName := getBucketsOwner(resp.Owner)
+
+	// Buckets are listed for the project of the API key, skip them if the
+	// connection is scoped to other projects
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.listObjectBuckets", "project_resolution_error", err)
+		return nil, err
+	}
+	if !isProjectInScope(projectIDs, bucketOwner) {
+		return nil, nil
+	}
+
+	// The name quals not served by the get, e.g. of a join or an in list, are pushed down
+	names := getQualsEqualsValues(d, "name")
+
 	for _, bucket := range resp.Buckets {
-		d.StreamListItem(ctx, bucketInfo{*bucket, region, bucketOwner})
+		// Skip the other buckets before their hydrate functions are called
+		if len(names) > 0 && !slices.Contains(names, aws.StringValue(bucket.Name)) {
+			continue
+		}
+
+		d.StreamListItem(ctx, bucketInfo{*bucket, region, bucketOwner, bucketOwnerDisplayName})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
 		if d.RowsRemaining(ctx) == 0 {
@@ -160,6 +282,120 @@ func listObjectBuckets(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 //// HYDRATE FUNCTIONS
 
+func getObjectBucket(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString("region")
+	name := d.EqualsQualString("name")
+
+	// No inputs
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getObjectSessionConfig(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getObjectBucket", "connection_error", err)
+		return nil, err
+	}
+
+	// Resolve the region of the bucket, so the bucket is only listed and
+	// hydrated in its region
+	bucketRegion, err := getBucketRegion(ctx, client, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getObjectBucket", "query_error", err)
+		return nil, err
+	}
+	if bucketRegion != region {
+		return nil, nil
+	}
+
+	// The creation date and the project of the bucket are only listed
+	resp, err := client.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getObjectBucket", "query_error", err)
+		return nil, err
+	}
+
+	bucketOwner, bucketOwnerDisplayName := getBucketsOwner(resp.Owner)
+
+	// Buckets are listed for the project of the API key, skip them if the
+	// connection is scoped to other projects
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getObjectBucket", "project_resolution_error", err)
+		return nil, err
+	}
+	if !isProjectInScope(projectIDs, bucketOwner) {
+		return nil, nil
+	}
+
+	for _, bucket := range resp.Buckets {
+		if aws.StringValue(bucket.Name) == name {
+			return bucketInfo{*bucket, region, bucketOwner, bucketOwnerDisplayName}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getBucketRegion :: returns the region of the bucket, or an empty string if the bucket doesn't exist.
+// The region is read from the x-amz-bucket-region header of HeadBucket, or else from the location
+// constraint of the bucket, an empty location constraint being the default fr-par region.
+func getBucketRegion(ctx context.Context, client *s3.S3, bucket string) (string, error) {
+	req, _ := client.HeadBucketRequest(&s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	req.SetContext(ctx)
+
+	// A bucket of another region may be redirected, the header is still set
+	req.DisableFollowRedirects = true
+
+	var bucketRegion string
+	req.Handlers.Send.PushBack(func(r *request.Request) {
+		if r.HTTPResponse == nil {
+			return
+		}
+		bucketRegion = r.HTTPResponse.Header.Get("X-Amz-Bucket-Region")
+		if bucketRegion != "" {
+			r.HTTPResponse.StatusCode = http.StatusOK
+			r.Error = nil
+		}
+	})
+	if err := req.Send(); err != nil {
+		if a, ok := err.(awserr.Error); ok && (a.Code() == "NotFound" || a.Code() == s3.ErrCodeNoSuchBucket) {
+			return "", nil
+		}
+		return "", err
+	}
+	if bucketRegion != "" {
+		return bucketRegion, nil
+	}
+
+	location, err := client.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return "", err
+	}
+	if constraint := aws.StringValue(location.LocationConstraint); constraint != "" {
+		return constraint, nil
+	}
+	return scw.RegionFrPar.String(), nil
+}
+
+// getBucketsOwner :: returns the project and the display name of the owner of the listed buckets,
+// whose ID is "<project ID>:<project ID>"
+func getBucketsOwner(owner *s3.Owner) (string, string) {
+	if owner == nil {
+		return "", ""
+	}
+	ownerID := aws.StringValue(owner.ID)
+	if project, _, found := strings.Cut(ownerID, ":"); found {
+		ownerID = project
+	}
+	return ownerID, aws.StringValue(owner.DisplayName)
+}
+
 func getBucketVersioning(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	matrixRegion := d.EqualsQualString("region")
 	bucket := h.Item.(bucketInfo)
@@ -228,7 +464,7 @@ func getBucketPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	// Create client
 	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketIsPublic", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketPolicy", "connection_error", err)
 		return nil, err
 	}
 
@@ -259,7 +495,7 @@ func getBucketLifecycle(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	// Create client
 	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketIsPublic", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketLifecycle", "connection_error", err)
 		return nil, err
 	}
 
@@ -290,7 +526,7 @@ func getBucketACL(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 	// Create client
 	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketIsPublic", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketACL", "connection_error", err)
 		return nil, err
 	}
 
@@ -298,6 +534,7 @@ func getBucketACL(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 		Bucket: bucket.Name,
 	})
 	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketACL", "query_error", err)
 		return nil, err
 	}
 
@@ -315,14 +552,19 @@ func getBucketTagging(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	// Create client
 	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketIsPublic", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketTagging", "connection_error", err)
 		return nil, err
 	}
 
-	bucketTags, _ := client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+	bucketTags, err := client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
 		Bucket: bucket.Name,
 	})
 	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchTagSet" {
+				return nil, nil
+			}
+		}
 		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketTagging", "query_error", err)
 		return nil, err
 	}
@@ -341,14 +583,19 @@ func getBucketCors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	// Create client
 	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketIsPublic", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketCors", "connection_error", err)
 		return nil, err
 	}
 
-	data, _ := client.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
+	data, err := client.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
 		Bucket: bucket.Name,
 	})
 	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchCORSConfiguration" {
+				return nil, nil
+			}
+		}
 		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketCors", "query_error", err)
 		return nil, err
 	}
@@ -367,17 +614,84 @@ func getBucketWebsite(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	// Create client
 	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketIsPublic", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketWebsite", "connection_error", err)
 		return nil, err
 	}
 
-	data, _ := client.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
+	data, err := client.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
 		Bucket: bucket.Name,
 	})
 	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchWebsiteConfiguration" {
+				return nil, nil
+			}
+		}
 		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketWebsite", "query_error", err)
 		return nil, err
 	}
 
 	return data, nil
 }
+
+func getBucketObjectLockConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixRegion := d.EqualsQualString("region")
+	bucket := h.Item.(bucketInfo)
+
+	if matrixRegion != bucket.Region {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketObjectLockConfiguration", "connection_error", err)
+		return nil, err
+	}
+
+	data, err := client.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: bucket.Name,
+	})
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "ObjectLockConfigurationNotFoundError" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketObjectLockConfiguration", "query_error", err)
+		return nil, err
+	}
+
+	return data.ObjectLockConfiguration, nil
+}
+
+func getBucketEncryption(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixRegion := d.EqualsQualString("region")
+	bucket := h.Item.(bucketInfo)
+
+	if matrixRegion != bucket.Region {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketEncryption", "connection_error", err)
+		return nil, err
+	}
+
+	data, err := client.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+		Bucket: bucket.Name,
+	})
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("scaleway_object_bucket.getBucketEncryption", "query_error", err)
+		return nil, err
+	}
+
+	return data, nil
+}
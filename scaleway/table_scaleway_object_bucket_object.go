@@ -0,0 +1,225 @@
+package scaleway
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayObjectBucketObject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_object_bucket_object",
+		Description:       "The objects stored in a Scaleway Object Storage bucket.",
+		Tags:              map[string]string{"service": "s3"},
+		GetMatrixItemFunc: BuildRegionList,
+		List: &plugin.ListConfig{
+			Hydrate: listObjectBucketObjects,
+			Tags:    map[string]string{"action": "ListObjectsV2"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "bucket",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "prefix",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "key",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "region",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The name of the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "prefix",
+				Description: "The prefix the objects were listed with, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Prefix").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "size",
+				Description: "The size of the object, in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "etag",
+				Description: "The entity tag of the object, an MD5 digest of its content for the objects uploaded in a single part.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "storage_class",
+				Description: "The storage class of the object. Possible values are 'STANDARD', 'ONEZONE_IA' and 'GLACIER'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The time when the object was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the owner of the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OwnerID"),
+			},
+			{
+				Name:        "owner_display_name",
+				Description: "The display name of the owner of the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "region",
+				Description: "Specifies the region where the bucket resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		},
+	}
+}
+
+type bucketObjectInfo = struct {
+	Key              string
+	Bucket           string
+	Prefix           string
+	Size             int64
+	ETag             string
+	StorageClass     string
+	LastModified     *time.Time
+	OwnerID          string
+	OwnerDisplayName string
+	Region           string
+}
+
+//// LIST FUNCTION
+
+func listObjectBucketObjects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	matrixRegion := d.EqualsQualString("region")
+
+	bucket := d.EqualsQualString("bucket")
+	prefix := d.EqualsQualString("prefix")
+	key := d.EqualsQualString("key")
+
+	// The object is listed by its key, within the prefix if any
+	listPrefix := prefix
+	if key != "" {
+		if !strings.HasPrefix(key, prefix) {
+			return nil, nil
+		}
+		listPrefix = key
+	}
+
+	// Create client
+	client, err := getObjectSessionConfig(ctx, d, matrixRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket_object.listObjectBucketObjects", "connection_error", err)
+		return nil, err
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket:     aws.String(bucket),
+		FetchOwner: aws.Bool(true),
+	}
+	if listPrefix != "" {
+		input.Prefix = aws.String(listPrefix)
+	}
+
+	// Retrieve the list of objects, at most 1000 per page
+	maxResult := int64(1000)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+	input.MaxKeys = aws.Int64(maxResult)
+
+	// The object is the first one listed with its key as prefix, if it exists
+	if key != "" {
+		input.MaxKeys = aws.Int64(1)
+	}
+
+	for {
+		resp, err := client.ListObjectsV2WithContext(ctx, input)
+		if err != nil {
+			// The bucket resides in another region
+			if a, ok := err.(awserr.Error); ok {
+				if a.Code() == s3.ErrCodeNoSuchBucket {
+					return nil, nil
+				}
+			}
+			plugin.Logger(ctx).Error("scaleway_object_bucket_object.listObjectBucketObjects", "query_error", err)
+			return nil, err
+		}
+
+		for _, object := range resp.Contents {
+			if key != "" && aws.StringValue(object.Key) != key {
+				continue
+			}
+
+			item := bucketObjectInfo{
+				Key:          aws.StringValue(object.Key),
+				Bucket:       bucket,
+				Prefix:       prefix,
+				Size:         aws.Int64Value(object.Size),
+				ETag:         aws.StringValue(object.ETag),
+				StorageClass: aws.StringValue(object.StorageClass),
+				LastModified: object.LastModified,
+				Region:       matrixRegion,
+			}
+			if object.Owner != nil {
+				item.OwnerID = aws.StringValue(object.Owner.ID)
+				item.OwnerDisplayName = aws.StringValue(object.Owner.DisplayName)
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if key != "" || !aws.BoolValue(resp.IsTruncated) {
+			break
+		}
+		input.ContinuationToken = resp.NextContinuationToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,265 @@
+package scaleway
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayObjectBucketObjectVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_object_bucket_object_version",
+		Description:       "The versions and delete markers of the objects stored in a Scaleway Object Storage bucket.",
+		Tags:              map[string]string{"service": "s3"},
+		GetMatrixItemFunc: BuildRegionList,
+		List: &plugin.ListConfig{
+			Hydrate: listObjectBucketObjectVersions,
+			Tags:    map[string]string{"action": "ListObjectVersions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "bucket",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "prefix",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "key",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "region",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The name of the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_id",
+				Description: "The ID of the version of the object, 'null' for the version stored before the versioning was enabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VersionID"),
+			},
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "prefix",
+				Description: "The prefix the versions were listed with, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Prefix").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "is_latest",
+				Description: "Indicates whether the version is the current version of the object.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_delete_marker",
+				Description: "Indicates whether the version is a delete marker, the version created when a versioned object is deleted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "size",
+				Description: "The size of the version, in bytes. Null for the delete markers.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "etag",
+				Description: "The entity tag of the version. Null for the delete markers.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "storage_class",
+				Description: "The storage class of the version. Possible values are 'STANDARD', 'ONEZONE_IA' and 'GLACIER'. Null for the delete markers.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The time when the version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the owner of the version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OwnerID"),
+			},
+			{
+				Name:        "owner_display_name",
+				Description: "The display name of the owner of the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "region",
+				Description: "Specifies the region where the bucket resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		},
+	}
+}
+
+type bucketObjectVersionInfo = struct {
+	Key              string
+	VersionID        string
+	Bucket           string
+	Prefix           string
+	IsLatest         bool
+	IsDeleteMarker   bool
+	Size             *int64
+	ETag             *string
+	StorageClass     *string
+	LastModified     *time.Time
+	OwnerID          string
+	OwnerDisplayName string
+	Region           string
+}
+
+//// LIST FUNCTION
+
+func listObjectBucketObjectVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	matrixRegion := d.EqualsQualString("region")
+
+	bucket := d.EqualsQualString("bucket")
+	prefix := d.EqualsQualString("prefix")
+	key := d.EqualsQualString("key")
+
+	// The versions of the object are listed by its key, within the prefix if any
+	listPrefix := prefix
+	if key != "" {
+		if !strings.HasPrefix(key, prefix) {
+			return nil, nil
+		}
+		listPrefix = key
+	}
+
+	// Create client
+	client, err := getObjectSessionConfig(ctx, d, matrixRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket_object_version.listObjectBucketObjectVersions", "connection_error", err)
+		return nil, err
+	}
+
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+	}
+	if listPrefix != "" {
+		input.Prefix = aws.String(listPrefix)
+	}
+
+	// Retrieve the list of versions, at most 1000 per page
+	maxResult := int64(1000)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+	input.MaxKeys = aws.Int64(maxResult)
+
+	for {
+		resp, err := client.ListObjectVersionsWithContext(ctx, input)
+		if err != nil {
+			// The bucket resides in another region
+			if a, ok := err.(awserr.Error); ok {
+				if a.Code() == s3.ErrCodeNoSuchBucket {
+					return nil, nil
+				}
+			}
+			plugin.Logger(ctx).Error("scaleway_object_bucket_object_version.listObjectBucketObjectVersions", "query_error", err)
+			return nil, err
+		}
+
+		// The keys are listed in order, the versions of the object are all
+		// listed once a following key is
+		var pastKey bool
+
+		var items []bucketObjectVersionInfo
+		for _, version := range resp.Versions {
+			item := bucketObjectVersionInfo{
+				Key:          aws.StringValue(version.Key),
+				VersionID:    aws.StringValue(version.VersionId),
+				IsLatest:     aws.BoolValue(version.IsLatest),
+				Size:         version.Size,
+				ETag:         version.ETag,
+				StorageClass: version.StorageClass,
+				LastModified: version.LastModified,
+			}
+			if version.Owner != nil {
+				item.OwnerID = aws.StringValue(version.Owner.ID)
+				item.OwnerDisplayName = aws.StringValue(version.Owner.DisplayName)
+			}
+			items = append(items, item)
+		}
+		for _, marker := range resp.DeleteMarkers {
+			item := bucketObjectVersionInfo{
+				Key:            aws.StringValue(marker.Key),
+				VersionID:      aws.StringValue(marker.VersionId),
+				IsLatest:       aws.BoolValue(marker.IsLatest),
+				IsDeleteMarker: true,
+				LastModified:   marker.LastModified,
+			}
+			if marker.Owner != nil {
+				item.OwnerID = aws.StringValue(marker.Owner.ID)
+				item.OwnerDisplayName = aws.StringValue(marker.Owner.DisplayName)
+			}
+			items = append(items, item)
+		}
+
+		for _, item := range items {
+			if key != "" && item.Key != key {
+				pastKey = pastKey || item.Key > key
+				continue
+			}
+
+			item.Bucket = bucket
+			item.Prefix = prefix
+			item.Region = matrixRegion
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if pastKey || !aws.BoolValue(resp.IsTruncated) {
+			break
+		}
+		input.KeyMarker = resp.NextKeyMarker
+		input.VersionIdMarker = resp.NextVersionIdMarker
+	}
+
+	return nil, nil
+}
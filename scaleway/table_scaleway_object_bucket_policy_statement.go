@@ -0,0 +1,215 @@
+package scaleway
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayObjectBucketPolicyStatement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_object_bucket_policy_statement",
+		Description:       "The statements of the Scaleway Object Storage bucket policies, normalized and analyzed for risky grants.",
+		Tags:              map[string]string{"service": "s3"},
+		GetMatrixItemFunc: BuildRegionList,
+		List: &plugin.ListConfig{
+			Hydrate:       listObjectBucketPolicyStatements,
+			ParentHydrate: listObjectBuckets,
+			Tags:          map[string]string{"action": "GetBucketPolicy"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "bucket",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket of the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "statement_index",
+				Description: "The position of the statement in the policy, starting at 0.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Statement.Index"),
+			},
+			{
+				Name:        "sid",
+				Description: "The identifier of the statement.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.Sid").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "effect",
+				Description: "Whether the statement allows or denies the access. Possible values are 'Allow' and 'Deny'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.Effect"),
+			},
+			{
+				Name:        "principals",
+				Description: "The principals of the statement, '*' for anyone or e.g. 'project_id:<id>', 'user_id:<id>' or 'application_id:<id>'.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Statement.Principals"),
+			},
+			{
+				Name:        "actions",
+				Description: "The actions of the statement, e.g. 's3:GetObject' or 's3:*'.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Statement.Actions"),
+			},
+			{
+				Name:        "resources",
+				Description: "The resources of the statement, the bucket or e.g. '<bucket>/*' for its objects.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Statement.Resources"),
+			},
+			{
+				Name:        "conditions",
+				Description: "The conditions of the statement, e.g. on the source IP address.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Statement.Conditions"),
+			},
+			{
+				Name:        "allows_anonymous",
+				Description: "Indicates whether the statement allows anyone, authenticated or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Statement.AllowsAnonymous"),
+			},
+			{
+				Name:        "allows_cross_project",
+				Description: "Indicates whether the statement allows the principals of another project than the project of the bucket.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Statement.AllowsCrossProject"),
+			},
+			{
+				Name:        "grants_write",
+				Description: "Indicates whether the statement allows an action modifying the bucket or its objects, e.g. s3:PutObject or s3:DeleteObject.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Statement.GrantsWrite"),
+			},
+			{
+				Name:        "policy_id",
+				Description: "The identifier of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyID").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "policy_version",
+				Description: "The version of the policy language.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "region",
+				Description: "Specifies the region where the bucket resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project",
+				Description: "The ID of the project where the bucket resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(bucketPolicyStatementTitle),
+			},
+		},
+	}
+}
+
+type bucketPolicyStatementInfo = struct {
+	Bucket        string
+	Statement     bucketPolicyStatement
+	PolicyID      string
+	PolicyVersion string
+	Region        string
+	Project       string
+}
+
+//// LIST FUNCTION
+
+func listObjectBucketPolicyStatements(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixRegion := d.EqualsQualString("region")
+	bucket := h.Item.(bucketInfo)
+
+	if matrixRegion != bucket.Region {
+		return nil, nil
+	}
+
+	// Skip the policies of the other buckets
+	quals := d.EqualsQuals
+	if quals["bucket"] != nil && quals["bucket"].GetStringValue() != aws.StringValue(bucket.Name) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getObjectSessionConfig(ctx, d, bucket.Region)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket_policy_statement.listObjectBucketPolicyStatements", "connection_error", err)
+		return nil, err
+	}
+
+	bucketPolicy, err := client.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+		Bucket: bucket.Name,
+	})
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchBucketPolicy" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("scaleway_object_bucket_policy_statement.listObjectBucketPolicyStatements", "query_error", err)
+		return nil, err
+	}
+
+	// The statements are analyzed locally, on the parsed policy
+	document, statements, err := parseBucketPolicy(aws.StringValue(bucketPolicy.Policy), bucket.Project)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_object_bucket_policy_statement.listObjectBucketPolicyStatements", "policy_parsing_error", err)
+		return nil, err
+	}
+
+	for _, statement := range statements {
+		d.StreamListItem(ctx, bucketPolicyStatementInfo{
+			Bucket:        aws.StringValue(bucket.Name),
+			Statement:     statement,
+			PolicyID:      document.ID,
+			PolicyVersion: document.Version,
+			Region:        bucket.Region,
+			Project:       bucket.Project,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func bucketPolicyStatementTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	info := d.HydrateItem.(bucketPolicyStatementInfo)
+	if info.Statement.Sid != "" {
+		return info.Bucket + " " + info.Statement.Sid, nil
+	}
+	return info.Bucket + " statement " + strconv.Itoa(info.Statement.Index), nil
+}
@@ -15,8 +15,9 @@ import (
 
 func tableScalewayRDBDatabase(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_rdb_database",
-		Description:   "A RDB database is a logical databases on your instance.",
+		Name:              "scaleway_rdb_database",
+		Description:       "A RDB database is a logical databases on your instance.",
+		Tags:              map[string]string{"service": "rdb"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate:       listRDBDatabases,
@@ -114,7 +115,7 @@ func listRDBDatabases(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 
 	parseRegionData, err := scw.ParseRegion(region)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_rdb_instance.listRDBDatabases", "region_parsing_error", err)
+		plugin.Logger(ctx).Error("scaleway_rdb_database.listRDBDatabases", "region_parsing_error", err)
 		return nil, err
 	}
 
@@ -129,7 +130,7 @@ func listRDBDatabases(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	// Create client
 	client, err := getSessionConfig(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_rdb_instance.listRDBDatabases", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_rdb_database.listRDBDatabases", "connection_error", err)
 		return nil, err
 	}
 
@@ -183,7 +184,7 @@ func listRDBDatabases(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	for {
 		resp, err := rdbApi.ListDatabases(req)
 		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_rdb_instance.listRDBDatabases", "query_error", err)
+			plugin.Logger(ctx).Error("scaleway_rdb_database.listRDBDatabases", "query_error", err)
 			return nil, err
 		}
 
@@ -15,8 +15,9 @@ import (
 
 func tableScalewayRDBInstance(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_rdb_instance",
-		Description:   "A Database Instance is composed of one or more Nodes, depending of the is_ha_cluster setting.",
+		Name:              "scaleway_rdb_instance",
+		Description:       "A Database Instance is composed of one or more Nodes, depending of the is_ha_cluster setting.",
+		Tags:              map[string]string{"service": "rdb"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate: listRDBInstances,
@@ -67,6 +68,7 @@ func tableScalewayRDBInstance(_ context.Context) *plugin.Table {
 				Name:        "is_has_cluster",
 				Description: "Indicates whether High-Availability is enabled, or not.",
 				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsHaCluster"),
 			},
 			{
 				Name:        "node_type",
@@ -187,32 +189,44 @@ func listRDBInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_rdb_instance.listRDBInstances", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := rdbApi.ListInstances(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_rdb_instance.listRDBInstances", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.ProjectID = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
 
-		for _, instance := range resp.Instances {
-			d.StreamListItem(ctx, instance)
+		for {
+			resp, err := rdbApi.ListInstances(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_rdb_instance.listRDBInstances", "query_error", err)
+				return nil, err
+			}
 
-			// Increase the resource count by 1
-			count++
+			for _, instance := range resp.Instances {
+				d.StreamListItem(ctx, instance)
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Increase the resource count by 1
+				count++
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
-		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 
+		}
 	}
 
 	return nil, nil
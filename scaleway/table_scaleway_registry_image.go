@@ -17,6 +17,7 @@ func tableScalewayRegistryImage(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:              "scaleway_registry_image",
 		Description:       "Registry Images allow you to manage your docker images in Scaleway.",
+		Tags:              map[string]string{"service": "registry"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate:       listRegistryImages,
@@ -25,7 +26,7 @@ func tableScalewayRegistryImage(_ context.Context) *plugin.Table {
 				{
 					Name:    "name",
 					Require: plugin.Optional,
-				}, 
+				},
 				{
 					Name:    "namespace_id",
 					Require: plugin.Optional,
@@ -164,31 +165,43 @@ func listRegistryImages(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_registry_image.listRegistryImages", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := registryApi.ListImages(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_registry_image.listRegistryImages", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.ProjectID = projectID
+		req.Page = scw.Int32Ptr(1)
 
-		for _, image := range resp.Images {
-			d.StreamListItem(ctx, imageInfo{*image, namespaceData.ID, namespaceData.Region, namespaceData.ProjectID, namespaceData.OrganizationID})
+		var count int
 
-			// Increase the resource count by 1
-			count++
+		for {
+			resp, err := registryApi.ListImages(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_registry_image.listRegistryImages", "query_error", err)
+				return nil, err
+			}
+
+			for _, image := range resp.Images {
+				d.StreamListItem(ctx, imageInfo{*image, namespaceData.ID, namespaceData.Region, namespaceData.ProjectID, namespaceData.OrganizationID})
+
+				// Increase the resource count by 1
+				count++
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
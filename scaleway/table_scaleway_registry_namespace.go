@@ -17,6 +17,7 @@ func tableScalewayRegistryNamespace(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:              "scaleway_registry_namespace",
 		Description:       "Registry Namespaces allow you to manage your Container Registry in Scaleway.",
+		Tags:              map[string]string{"service": "registry"},
 		GetMatrixItemFunc: BuildRegionList,
 		List: &plugin.ListConfig{
 			Hydrate: listRegistryNamespaces,
@@ -109,11 +110,13 @@ func tableScalewayRegistryNamespace(_ context.Context) *plugin.Table {
 				Name:        "project",
 				Description: "The ID of the project where the namespace resides.",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
 			},
 			{
 				Name:        "organization",
 				Description: "The ID of the organization where the namespace resides.",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrganizationID"),
 			},
 
 			// Steampipe standard columns
@@ -174,31 +177,43 @@ func listRegistryNamespaces(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_registry_namespace.listRegistryNamespaces", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := registryApi.ListNamespaces(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_registry_namespace.listRegistryNamespaces", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.ProjectID = projectID
+		req.Page = scw.Int32Ptr(1)
 
-		for _, namespace := range resp.Namespaces {
-			d.StreamListItem(ctx, namespace)
+		var count int
 
-			// Increase the resource count by 1
-			count++
+		for {
+			resp, err := registryApi.ListNamespaces(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_registry_namespace.listRegistryNamespaces", "query_error", err)
+				return nil, err
+			}
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+			for _, namespace := range resp.Namespaces {
+				d.StreamListItem(ctx, namespace)
+
+				// Increase the resource count by 1
+				count++
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
 	}
 
 	return nil, nil
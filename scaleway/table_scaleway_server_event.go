@@ -0,0 +1,288 @@
+package scaleway
+
+import (
+	"context"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	serverTypeInstance  = "instance"
+	serverTypeBaremetal = "baremetal"
+)
+
+//// TABLE DEFINITION
+
+func tableScalewayServerEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:              "scaleway_server_event",
+		Description:       "The planned maintenances of the instance servers and the lifecycle events of the bare metal servers.",
+		Tags:              map[string]string{"service": "instance"},
+		GetMatrixItemFunc: BuildZoneList,
+		List: &plugin.ListConfig{
+			Hydrate: listServerEvents,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "server_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "server_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "zone",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "server_type",
+				Description: "The type of the server, instance or baremetal.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "server_id",
+				Description: "The ID of the server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerID"),
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "An unique identifier of the bare metal server event. Null for the instance server maintenances.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "action",
+				Description: "The action performed on the bare metal server, e.g. install or reboot. Null for the instance server maintenances.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reason",
+				Description: "The reason of the instance server maintenance. Null for the bare metal server events.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time when the instance server maintenance starts, or when the bare metal server event was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time when the bare metal server event was last updated. Null for the instance server maintenances.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Scaleway standard columns
+			{
+				Name:        "zone",
+				Description: "Specifies the zone where the server resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(transform.ToString),
+			},
+			{
+				Name:        "project",
+				Description: "The ID of the project where the server resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "organization",
+				Description: "The ID of the organization where the server resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(serverEventTitle),
+			},
+		},
+	}
+}
+
+type serverEventInfo = struct {
+	ServerType   string
+	ServerID     string
+	ServerName   string
+	ID           string
+	Action       string
+	Reason       string
+	StartTime    *time.Time
+	UpdatedAt    *time.Time
+	Zone         scw.Zone
+	Project      string
+	Organization string
+}
+
+//// LIST FUNCTION
+
+func listServerEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	zone := d.EqualsQualString("zone")
+
+	parseZoneData, err := scw.ParseZone(zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_server_event.listServerEvents", "zone_parsing_error", err)
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	if quals["zone"] != nil && quals["zone"].GetStringValue() != zone {
+		return nil, nil
+	}
+	serverType := d.EqualsQualString("server_type")
+	serverID := d.EqualsQualString("server_id")
+
+	// Create client
+	client, err := getSessionConfig(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_server_event.listServerEvents", "connection_error", err)
+		return nil, err
+	}
+
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_server_event.listServerEvents", "project_resolution_error", err)
+		return nil, err
+	}
+
+	// The planned maintenances are listed with the instance servers
+	if serverType == "" || serverType == serverTypeInstance {
+		instanceApi := instance.NewAPI(client)
+
+		req := &instance.ListServersRequest{
+			Zone: parseZoneData,
+		}
+		if serverID != "" {
+			req.Servers = []string{serverID}
+		}
+
+		for _, projectID := range projectIDs {
+			req.Project = projectID
+
+			resp, err := instanceApi.ListServers(req, scw.WithAllPages())
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_server_event.listServerEvents", "query_error", err)
+				return nil, err
+			}
+
+			for _, server := range resp.Servers {
+				for _, maintenance := range server.Maintenances {
+					if maintenance == nil {
+						continue
+					}
+					d.StreamListItem(ctx, serverEventInfo{
+						ServerType:   serverTypeInstance,
+						ServerID:     server.ID,
+						ServerName:   server.Name,
+						Reason:       maintenance.Reason,
+						StartTime:    maintenance.StartDate,
+						Zone:         server.Zone,
+						Project:      server.Project,
+						Organization: server.Organization,
+					})
+
+					// Context can be cancelled due to manual cancellation or the limit has been hit
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	if serverType != "" && serverType != serverTypeBaremetal {
+		return nil, nil
+	}
+
+	// The lifecycle events are listed for each bare metal server, in the zones where bare metal is available
+	baremetalApi := baremetal.NewAPI(client)
+
+	var zoneAvailable bool
+	for _, v := range baremetalApi.Zones() {
+		if v == parseZoneData {
+			zoneAvailable = true
+		}
+	}
+	if !zoneAvailable {
+		return nil, nil
+	}
+
+	for _, projectID := range projectIDs {
+		resp, err := baremetalApi.ListServers(&baremetal.ListServersRequest{
+			Zone:      parseZoneData,
+			ProjectID: projectID,
+		}, scw.WithAllPages())
+		if err != nil {
+			plugin.Logger(ctx).Error("scaleway_server_event.listServerEvents", "query_error", err)
+			return nil, err
+		}
+
+		for _, server := range resp.Servers {
+			if serverID != "" && server.ID != serverID {
+				continue
+			}
+
+			// The events are listed with one request per server, each one is rate limited
+			d.WaitForListRateLimit(ctx)
+
+			events, err := baremetalApi.ListServerEvents(&baremetal.ListServerEventsRequest{
+				Zone:     parseZoneData,
+				ServerID: server.ID,
+				OrderBy:  baremetal.ListServerEventsRequestOrderByCreatedAtDesc,
+			}, scw.WithAllPages())
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_server_event.listServerEvents", "query_error", err)
+				return nil, err
+			}
+
+			for _, event := range events.Events {
+				d.StreamListItem(ctx, serverEventInfo{
+					ServerType:   serverTypeBaremetal,
+					ServerID:     server.ID,
+					ServerName:   server.Name,
+					ID:           event.ID,
+					Action:       event.Action,
+					StartTime:    event.CreatedAt,
+					UpdatedAt:    event.UpdatedAt,
+					Zone:         server.Zone,
+					Project:      server.ProjectID,
+					Organization: server.OrganizationID,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serverEventTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	event := d.HydrateItem.(serverEventInfo)
+	if event.ServerType == serverTypeInstance {
+		return event.ServerName + " maintenance", nil
+	}
+	return event.ServerName + " " + event.Action, nil
+}
@@ -15,8 +15,9 @@ import (
 
 func tableScalewayVPCPrivateNetwork(_ context.Context) *plugin.Table {
 	return &plugin.Table{
-		Name:          "scaleway_vpc_private_network",
-		Description:   "A VPC private network allows interconnecting your instances in an isolated and private network.",
+		Name:              "scaleway_vpc_private_network",
+		Description:       "A VPC private network allows interconnecting your instances in an isolated and private network.",
+		Tags:              map[string]string{"service": "vpc"},
 		GetMatrixItemFunc: BuildZoneList,
 		List: &plugin.ListConfig{
 			Hydrate: listVPCPrivateNetworks,
@@ -141,32 +142,44 @@ func listVPCPrivateNetworks(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 	req.PageSize = scw.Uint32Ptr(uint32(maxResult))
 
-	var count int
+	// Scope the listing to the projects of the connection config, if any
+	projectIDs, err := getProjectIDs(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("scaleway_vpc_private_network.listVPCPrivateNetworks", "project_resolution_error", err)
+		return nil, err
+	}
 
-	for {
-		resp, err := vpcApi.ListPrivateNetworks(req)
-		if err != nil {
-			plugin.Logger(ctx).Error("scaleway_vpc_private_network.listVPCPrivateNetworks", "query_error", err)
-			return nil, err
-		}
+	for _, projectID := range projectIDs {
+		req.ProjectID = projectID
+		req.Page = scw.Int32Ptr(1)
+
+		var count int
+
+		for {
+			resp, err := vpcApi.ListPrivateNetworks(req)
+			if err != nil {
+				plugin.Logger(ctx).Error("scaleway_vpc_private_network.listVPCPrivateNetworks", "query_error", err)
+				return nil, err
+			}
 
-		for _, network := range resp.PrivateNetworks {
-			d.StreamListItem(ctx, network)
+			for _, network := range resp.PrivateNetworks {
+				d.StreamListItem(ctx, network)
 
-			// Increase the resource count by 1
-			count++
+				// Increase the resource count by 1
+				count++
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
 			}
-		}
 
-		if resp.TotalCount == uint32(count) {
-			break
-		}
-		req.Page = scw.Int32Ptr(*req.Page + 1)
+			if resp.TotalCount == uint32(count) {
+				break
+			}
+			req.Page = scw.Int32Ptr(*req.Page + 1)
 
+		}
 	}
 
 	return nil, nil
@@ -190,7 +203,7 @@ func getVPCPrivateNetwork(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	// Create client
 	client, err := getSessionConfig(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("scaleway_vpc_private_network.listVPCPrivateNetworks", "connection_error", err)
+		plugin.Logger(ctx).Error("scaleway_vpc_private_network.getVPCPrivateNetwork", "connection_error", err)
 		return nil, err
 	}
 
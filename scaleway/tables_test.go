@@ -0,0 +1,1474 @@
+package scaleway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+const notFoundID = "00000000-0000-0000-0000-000000000000"
+
+// tableTest describes the queries run against a table and their expected results
+type tableTest struct {
+	// name of the test, the table name by default
+	name    string
+	table   string
+	fixture string
+	// config is appended to the test connection config
+	config string
+	// quals of the list query
+	quals map[string]string
+	// limit of the list query, if any
+	limit int64
+	// key is the column identifying rows in want, id by default
+	key string
+	// rows is the number of rows returned by the list query
+	rows int
+	// want are the expected column values of some of the listed rows
+	want map[string]map[string]interface{}
+	// requests are the expected number of requests per path of the list query, e.g. one per page.
+	// The path may have query parameters to only count the requests setting them
+	requests map[string]int
+	// get are the quals of a get query returning the row with the same key
+	get map[string]string
+	// notFound are the quals of a get query of a missing resource, which returns no row
+	notFound map[string]string
+}
+
+var tableTests = []tableTest{
+	{
+		table:   "scaleway_account_project",
+		fixture: "account",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			testProjectID: {
+				"name":            "default",
+				"description":     "Default project",
+				"organization_id": testOrganizationID,
+				"created_at":      "2022-12-01T10:00:00Z",
+			},
+		},
+		get:      map[string]string{"id": testProjectID},
+		notFound: map[string]string{"id": notFoundID},
+	},
+	{
+		table:   "scaleway_account_ssh_key",
+		fixture: "account",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"d6e7f8a9-b0c1-4d2e-9f3a-4b5c6d7e8f90": {
+				"name":          "alice@laptop",
+				"fingerprint":   "256 MD5:3b:9e:51:0c:7a:2d:f4:18:66:c1:0e:8b:93:57:aa:21 alice@laptop (ssh-ed25519)",
+				"creation_info": map[string]interface{}{"address": "198.51.100.7", "user_agent": "Mozilla/5.0", "country_code": "FR"},
+				"project":       testProjectID,
+				"organization":  testOrganizationID,
+			},
+		},
+		get:      map[string]string{"id": "d6e7f8a9-b0c1-4d2e-9f3a-4b5c6d7e8f90"},
+		notFound: map[string]string{"id": notFoundID},
+	},
+	{
+		table:   "scaleway_baremetal_server",
+		fixture: "baremetal_server",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"e4a1b2c3-d4e5-4f60-9a7b-8c9d0e1f2a30": {
+				"name":         "db-metal-1",
+				"status":       "ready",
+				"boot_type":    "normal",
+				"offer_name":   "EM-A210R-HDD",
+				"ping_status":  "ping_status_up",
+				"tags":         []interface{}{"db"},
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+				"title":        "db-metal-1",
+			},
+		},
+		get:      map[string]string{"id": "e4a1b2c3-d4e5-4f60-9a7b-8c9d0e1f2a30", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		table:   "scaleway_billing_consumption",
+		fixture: "billing",
+		key:     "resource_name",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"web-1": {
+				"category_name":   "Compute",
+				"product_name":    "DEV1-S",
+				"project_id":      testProjectID,
+				"sku":             "/compute/dev1_s/run_par1",
+				"value":           map[string]interface{}{"currency_code": "EUR", "units": 12, "nanos": 340000000},
+				"billed_quantity": 730,
+				"organization_id": testOrganizationID,
+			},
+		},
+	},
+	{
+		table:   "scaleway_billing_invoice",
+		fixture: "billing",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"a9b0c1d2-e3f4-4a5b-8c6d-7e8f9a0b1c23": {
+				"organization_id":       testOrganizationID,
+				"type":                  "periodic",
+				"state":                 "paid",
+				"number":                1042,
+				"billing_period":        "2023-09-01T00:00:00Z",
+				"total_untaxed_amount":  100,
+				"total_taxed_amount":    120,
+				"total_discount_amount": 0,
+				"currency":              "EUR",
+				"title":                 "1042",
+			},
+			"b0c1d2e3-f4a5-4b6c-9d7e-8f9a0b1c2d34": {
+				"total_untaxed_amount": 95.5,
+				"total_taxed_amount":   114.6,
+			},
+		},
+		requests: map[string]int{"GET /billing/v2beta1/invoices": 2},
+		get:      map[string]string{"id": "a9b0c1d2-e3f4-4a5b-8c6d-7e8f9a0b1c23"},
+		notFound: map[string]string{"id": notFoundID},
+	},
+	{
+		table:   "scaleway_iam_api_key",
+		fixture: "iam",
+		key:     "access_key",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"SCWABCDEFGHIJKLMNOPQ": {
+				"secret_key":         "",
+				"user_id":            "e7f8a9b0-c1d2-4e3f-8a4b-5c6d7e8f9a01",
+				"description":        "terraform",
+				"default_project_id": testProjectID,
+				"editable":           true,
+				"creation_ip":        "198.51.100.7",
+				"organization_id":    testOrganizationID,
+			},
+		},
+		get:      map[string]string{"access_key": "SCWABCDEFGHIJKLMNOPQ"},
+		notFound: map[string]string{"access_key": "SCW00000000000000000"},
+	},
+	{
+		table:   "scaleway_iam_user",
+		fixture: "iam",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"e7f8a9b0-c1d2-4e3f-8a4b-5c6d7e8f9a01": {
+				"email":              "alice@example.com",
+				"type":               "owner",
+				"two_factor_enabled": true,
+				"status":             "activated",
+				"organization_id":    testOrganizationID,
+				"title":              "alice@example.com",
+			},
+			"f8a9b0c1-d2e3-4f4a-9b5c-6d7e8f9a0b12": {
+				"email":         "bob@example.com",
+				"last_login_at": nil,
+				"status":        "invitation_pending",
+			},
+		},
+		requests: map[string]int{"GET /iam/v1alpha1/users": 2},
+		get:      map[string]string{"id": "e7f8a9b0-c1d2-4e3f-8a4b-5c6d7e8f9a01"},
+		notFound: map[string]string{"id": notFoundID},
+	},
+	{
+		table:   "scaleway_instance_image",
+		fixture: "instance_image",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"3c0d9f4e-0b1a-4b8e-9c2d-5e6f7a8b9c01": {
+				"name":          "web-golden-image",
+				"arch":          "x86_64",
+				"from_server":   "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10",
+				"public":        false,
+				"state":         "available",
+				"creation_date": "2023-09-12T08:00:00Z",
+				"extra_volumes": map[string]interface{}{
+					"1": map[string]interface{}{"id": "6e7f8a9b-0c1d-4e2f-8a3b-4c5d6e7f8a90", "name": "data", "size": 10000000000, "volume_type": "b_ssd", "state": "available", "zone": "fr-par-1", "creation_date": nil, "modification_date": nil, "export_uri": nil, "organization": "", "project": "", "server": nil, "tags": nil},
+				},
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+			},
+		},
+		get:      map[string]string{"id": "3c0d9f4e-0b1a-4b8e-9c2d-5e6f7a8b9c01", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		table:   "scaleway_instance_ip",
+		fixture: "instance_ip",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"7d2e4f6a-8b1c-4d3e-9f0a-1b2c3d4e5f60": {
+				"address": "51.15.220.10",
+				"reverse": "web-1.example.com",
+				"tags":    []interface{}{"web"},
+				"zone":    "fr-par-1",
+				"project": testProjectID,
+			},
+			"c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e70": {
+				"address": "51.15.220.11",
+				"reverse": nil,
+				"server":  nil,
+			},
+		},
+		get:      map[string]string{"id": "7d2e4f6a-8b1c-4d3e-9f0a-1b2c3d4e5f60", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		table:   "scaleway_instance_placement_group",
+		fixture: "instance_placement_group",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"5c6d7e8f-90a1-4b2c-8d3e-4f5a6b7c8d90": {
+				"name":             "orders-db-ha",
+				"policy_type":      "max_availability",
+				"policy_mode":      "enforced",
+				"policy_respected": true,
+				"server_ids":       []interface{}{"0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "8f1a2b3c-4d5e-4f60-8a7b-9c0d1e2f3a40"},
+				"servers": []interface{}{
+					map[string]interface{}{"id": "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "name": "orders-db-1", "policy_respected": true},
+					map[string]interface{}{"id": "8f1a2b3c-4d5e-4f60-8a7b-9c0d1e2f3a40", "name": "orders-db-2", "policy_respected": true},
+				},
+				"tags":         []interface{}{"db"},
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+			},
+			"6d7e8f90-a1b2-4c3d-9e4f-5a6b7c8d9e01": {
+				"policy_type":      "low_latency",
+				"policy_mode":      "optional",
+				"policy_respected": false,
+				"server_ids":       []interface{}{},
+			},
+			// Deleted between the list and the servers hydrate
+			"7e8f90a1-b2c3-4d4e-8f5a-6b7c8d9e0f12": {
+				"name":       "deleted",
+				"servers":    nil,
+				"server_ids": nil,
+			},
+		},
+		get:      map[string]string{"id": "5c6d7e8f-90a1-4b2c-8d3e-4f5a6b7c8d90", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		table:   "scaleway_instance_private_nic",
+		fixture: "instance_private_nic",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c51": {
+				"server_id":          "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10",
+				"server_name":        "web-1",
+				"private_network_id": "c5d6e7f8-a9b0-4c1d-8e2f-3a4b5c6d7e89",
+				"mac_address":        "02:00:00:12:34:56",
+				"state":              "available",
+				"ip_addresses":       []interface{}{"172.16.4.2/22", "fd5f:519c:6d46:2728:4f2a:1b3c:5d6e:7f80/64"},
+				"tags":               []interface{}{"backend"},
+				"zone":               "fr-par-1",
+				"project":            testProjectID,
+				"organization":       testOrganizationID,
+			},
+			// No IP is booked in IPAM for the private NIC
+			"b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d62": {
+				"private_network_id": "d6e7f8a9-b0c1-4d2e-8f3a-4b5c6d7e8f9a",
+				"state":              "syncing",
+				"ip_addresses":       nil,
+				"ips":                nil,
+			},
+			// IPAM denies the listing of the IPs
+			"c3d4e5f6-a7b8-4c9d-8e0f-2a3b4c5d6e73": {
+				"server_name":  "worker-1",
+				"state":        "available",
+				"ip_addresses": nil,
+				"ips":          nil,
+			},
+		},
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/servers": 1, "GET /ipam/v1/regions/fr-par/ips": 3},
+	},
+	{
+		name:    "scaleway_instance_private_nic/private_network",
+		table:   "scaleway_instance_private_nic",
+		fixture: "instance_private_nic",
+		quals:   map[string]string{"private_network_id": "c5d6e7f8-a9b0-4c1d-8e2f-3a4b5c6d7e89"},
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c51": {
+				"server_name": "web-1",
+			},
+		},
+	},
+	{
+		table:   "scaleway_instance_security_group",
+		fixture: "instance_security_group",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"1e2d3c4b-5a69-4788-9a0b-c1d2e3f4a5b6": {
+				"name":                    "Default security group",
+				"inbound_default_policy":  "accept",
+				"outbound_default_policy": "accept",
+				"enable_default_security": true,
+				"project_default":         true,
+				"stateful":                true,
+				"servers":                 []interface{}{map[string]interface{}{"id": "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "name": "web-1"}},
+				"zone":                    "fr-par-1",
+				"project":                 testProjectID,
+			},
+		},
+		get:      map[string]string{"id": "1e2d3c4b-5a69-4788-9a0b-c1d2e3f4a5b6", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		table:   "scaleway_instance_security_group_rule",
+		fixture: "instance_security_group",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"3a4b5c6d-7e8f-4091-a2b3-c4d5e6f7a812": {
+				"security_group_id":   "1e2d3c4b-5a69-4788-9a0b-c1d2e3f4a5b6",
+				"security_group_name": "Default security group",
+				"protocol":            "TCP",
+				"direction":           "inbound",
+				"action":              "accept",
+				"ip_range":            "0.0.0.0/0",
+				"dest_port_from":      22,
+				"dest_port_to":        nil,
+				"position":            2,
+				"editable":            true,
+				"zone":                "fr-par-1",
+				"project":             testProjectID,
+				"organization":        testOrganizationID,
+			},
+			"4b5c6d7e-8f90-4a1b-b3c4-d5e6f7a8b923": {
+				"protocol":       "UDP",
+				"ip_range":       "10.0.0.0/8",
+				"dest_port_from": 1024,
+				"dest_port_to":   65535,
+			},
+		},
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/security_groups/1e2d3c4b-5a69-4788-9a0b-c1d2e3f4a5b6/rules": 2},
+	},
+	{
+		name:     "scaleway_instance_security_group_rule/other_security_group",
+		table:    "scaleway_instance_security_group_rule",
+		fixture:  "instance_security_group",
+		quals:    map[string]string{"security_group_id": notFoundID},
+		rows:     0,
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/security_groups/1e2d3c4b-5a69-4788-9a0b-c1d2e3f4a5b6/rules": 0},
+	},
+	{
+		table:   "scaleway_instance_server",
+		fixture: "instance_server",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10": {
+				"name":                "web-1",
+				"state":               "running",
+				"commercial_type":     "DEV1-S",
+				"private_ip":          "10.64.12.5",
+				"public_ip":           map[string]interface{}{"id": "7d2e4f6a-8b1c-4d3e-9f0a-1b2c3d4e5f60", "address": "51.15.220.10", "dynamic": false, "family": "inet", "gateway": "", "ipam_id": "", "netmask": "", "provisioning_mode": "manual", "state": "unknown_state", "tags": nil},
+				"tags":                []interface{}{"env=prod", "web"},
+				"private_network_ids": []interface{}{},
+				"creation_date":       "2023-10-02T09:12:45Z",
+				"zone":                "fr-par-1",
+				"project":             testProjectID,
+				"organization":        testOrganizationID,
+				"title":               "web-1",
+			},
+			"8f1a2b3c-4d5e-4f60-8a7b-9c0d1e2f3a40": {
+				"name":       "worker-1",
+				"state":      "stopped",
+				"private_ip": nil,
+				"public_ip":  nil,
+			},
+		},
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/servers": 2},
+		get:      map[string]string{"id": "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		name:    "scaleway_instance_server/filters",
+		table:   "scaleway_instance_server",
+		fixture: "instance_server",
+		quals: map[string]string{
+			"tags ?":                "web",
+			"state":                 "running",
+			"private_ip":            "10.64.12.5",
+			"private_network_ids ?": "c5d6e7f8-a9b0-4c1d-8e2f-3a4b5c6d7e89",
+			"project":               testProjectID,
+		},
+		rows: 1,
+		want: map[string]map[string]interface{}{
+			"0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10": {
+				"private_network_ids": []interface{}{"c5d6e7f8-a9b0-4c1d-8e2f-3a4b5c6d7e89"},
+			},
+		},
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/servers": 1},
+	},
+	{
+		name:     "scaleway_instance_server/project_out_of_scope",
+		table:    "scaleway_instance_server",
+		fixture:  "instance_server",
+		config:   `projects = ["9a1e6e4c-2f0b-4a9d-8c4e-3b7d5f2a1c80"]`,
+		quals:    map[string]string{"project": notFoundID},
+		rows:     0,
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/servers": 0},
+	},
+	{
+		table:   "scaleway_instance_server_metric_cpu",
+		fixture: "instance_server_metric",
+		config:  `cockpit_token = "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"`,
+		quals:   map[string]string{"period": "3600", "timestamp >=": "2023-10-02T00:00:00Z", "timestamp <": "2023-10-02T01:00:00Z"},
+		key:     "server_id",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10": {
+				"server_name":  "web-1",
+				"metric_name":  "cpu_usage",
+				"unit":         "cores",
+				"timestamp":    "2023-10-02T00:00:00Z",
+				"period":       3600,
+				"average":      0.75,
+				"maximum":      1.5,
+				"minimum":      0.25,
+				"sample_count": 3,
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+			},
+			"8f1a2b3c-4d5e-4f60-8a7b-9c0d1e2f3a40": {
+				"server_name":  "worker-1",
+				"average":      0.01,
+				"maximum":      0.02,
+				"minimum":      0,
+				"sample_count": 2,
+			},
+		},
+		requests: map[string]int{"GET /cockpit/v1beta1/cockpit": 1, "GET /cockpit/prometheus/api/v1/query_range": 1},
+	},
+	{
+		table:   "scaleway_instance_server_metric_disk",
+		fixture: "instance_server_metric",
+		config:  `cockpit_token = "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"`,
+		quals:   map[string]string{"server_id": "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "period": "3600", "timestamp >=": "2023-10-02T00:00:00Z", "timestamp <": "2023-10-02T01:00:00Z"},
+		key:     "metric_name",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"disk_written": {"server_name": "web-1", "unit": "bytes/s", "average": 4096, "sample_count": 1},
+		},
+	},
+	{
+		table:   "scaleway_instance_server_metric_network",
+		fixture: "instance_server_metric",
+		config:  `cockpit_token = "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"`,
+		quals:   map[string]string{"server_id": "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "period": "3600", "timestamp >=": "2023-10-02T00:00:00Z", "timestamp <": "2023-10-02T01:00:00Z"},
+		key:     "metric_name",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"network_received":    {"server_name": "web-1", "unit": "bytes/s", "average": 2000, "maximum": 3000, "minimum": 1000},
+			"network_transmitted": {"server_name": "web-1", "average": 500, "sample_count": 1},
+		},
+	},
+	{
+		table:   "scaleway_instance_server_type",
+		fixture: "instance_server_type",
+		key:     "name",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"DEV1-S": {
+				"alt_names":             []interface{}{},
+				"availability":          "available",
+				"hourly_price":          0.0088,
+				"monthly_price":         6.4240003,
+				"arch":                  "x86_64",
+				"ncpus":                 2,
+				"ram":                   2147483648,
+				"gpu":                   0,
+				"block_storage":         true,
+				"boot_types":            []interface{}{"local", "rescue"},
+				"volumes_max_size":      20000000000,
+				"l_ssd_volume_min_size": 1000000000,
+				"l_ssd_volume_max_size": 20000000000,
+				"internet_bandwidth":    200000000,
+				"ipv6_support":          true,
+				"zone":                  "fr-par-1",
+				"title":                 "DEV1-S",
+			},
+			"GP1-XS": {
+				"alt_names":    []interface{}{"GP1-XS-C"},
+				"availability": "scarce",
+			},
+			"GPU-3070-S": {
+				"availability": "shortage",
+				"gpu":          1,
+			},
+		},
+		requests: map[string]int{
+			"GET /instance/v1/zones/fr-par-1/products/servers":              2,
+			"GET /instance/v1/zones/fr-par-1/products/servers/availability": 1,
+		},
+	},
+	{
+		table:   "scaleway_instance_server_user_data",
+		fixture: "instance_server_user_data",
+		key:     "key",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"cloud-init": {
+				"server_id":    "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10",
+				"server_name":  "web-1",
+				"value":        "#cloud-config\npackages:\n  - nginx\nruncmd:\n  - export DB_PASSWORD=hunter2\n",
+				"encoding":     "text",
+				"size":         73,
+				"truncated":    false,
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+				"title":        "cloud-init",
+			},
+			"ssh-host-keys": {
+				"value":     "",
+				"size":      0,
+				"truncated": false,
+			},
+			// A binary value with NUL bytes
+			"vendor-data": {
+				"value":     "HwAIAA==",
+				"encoding":  "base64",
+				"size":      4,
+				"truncated": false,
+			},
+		},
+		requests: map[string]int{
+			"GET /instance/v1/zones/fr-par-1/servers/0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10/user_data": 1,
+			"GET /instance/v1/zones/fr-par-1/servers/8f1a2b3c-4d5e-4f60-8a7b-9c0d1e2f3a40/user_data": 1,
+		},
+		get: map[string]string{"server_id": "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "key": "cloud-init"},
+	},
+	{
+		name:     "scaleway_instance_server_user_data/other_server",
+		table:    "scaleway_instance_server_user_data",
+		fixture:  "instance_server_user_data",
+		quals:    map[string]string{"server_id": "8f1a2b3c-4d5e-4f60-8a7b-9c0d1e2f3a40"},
+		rows:     0,
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/servers/0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10/user_data": 0},
+	},
+	{
+		table:   "scaleway_instance_snapshot",
+		fixture: "instance_snapshot",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"d1e2f3a4-b5c6-4d7e-8f90-a1b2c3d4e5f6": {
+				"name":                 "web-1-root-snapshot",
+				"size":                 20000000000,
+				"state":                "available",
+				"volume_type":          "l_ssd",
+				"snapshot_base_volume": map[string]interface{}{"id": "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c80", "name": "web-1-root"},
+				"base_volume_id":       "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c80",
+				"image_ids":            nil,
+				"orphaned":             true,
+				"zone":                 "fr-par-1",
+				"project":              testProjectID,
+			},
+			"2b3c4d5e-6f70-4819-a2b3-c4d5e6f7a8b9": {
+				"name":           "web-golden-image-root",
+				"base_volume_id": nil,
+				"image_ids":      []interface{}{"3c0d9f4e-0b1a-4b8e-9c2d-5e6f7a8b9c01"},
+				"orphaned":       false,
+			},
+		},
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/images": 1},
+		get:      map[string]string{"id": "d1e2f3a4-b5c6-4d7e-8f90-a1b2c3d4e5f6", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		name:    "scaleway_instance_snapshot/base_volume",
+		table:   "scaleway_instance_snapshot",
+		fixture: "instance_snapshot",
+		quals:   map[string]string{"base_volume_id": "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c80"},
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"d1e2f3a4-b5c6-4d7e-8f90-a1b2c3d4e5f6": {"name": "web-1-root-snapshot"},
+		},
+	},
+	{
+		table:   "scaleway_instance_volume",
+		fixture: "instance_volume",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c80": {
+				"name":         "web-1-root",
+				"size":         20000000000,
+				"state":        "in_use",
+				"volume_type":  "l_ssd",
+				"server":       map[string]interface{}{"id": "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10", "name": "web-1"},
+				"snapshot_ids": []interface{}{"a0b1c2d3-e4f5-4a6b-8c7d-9e0f1a2b3c4d", "d1e2f3a4-b5c6-4d7e-8f90-a1b2c3d4e5f6"},
+				"orphaned":     false,
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+			},
+			"7c8d9e0f-1a2b-4c3d-9e4f-5a6b7c8d9e01": {
+				"name":         "old-data",
+				"state":        "available",
+				"server":       nil,
+				"snapshot_ids": nil,
+				"orphaned":     true,
+			},
+		},
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/snapshots": 1},
+		get:      map[string]string{"id": "5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c80", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+	{
+		name:     "scaleway_instance_volume/filters",
+		table:    "scaleway_instance_volume",
+		fixture:  "instance_volume",
+		quals:    map[string]string{"tags ?": "archive", "volume_type": "b_ssd", "organization": testOrganizationID},
+		rows:     0,
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/volumes": 1},
+	},
+	{
+		table:   "scaleway_kubernetes_cluster",
+		fixture: "kubernetes",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"a7b8c9d0-e1f2-4a3b-9c4d-5e6f7a8b9c01": {
+				"name":              "prod",
+				"status":            "ready",
+				"type":              "kapsule",
+				"version":           "1.28.2",
+				"cni":               "cilium",
+				"dashboard_enabled": false,
+				"upgrade_available": true,
+				"auto_upgrade":      map[string]interface{}{"enabled": true, "maintenance_window": map[string]interface{}{"start_hour": 3, "day": "sunday"}},
+				"tags":              []interface{}{"env=prod"},
+				"region":            "fr-par",
+				"project":           testProjectID,
+				"organization":      testOrganizationID,
+			},
+			"b8c9d0e1-f2a3-4b4c-8d5e-6f7a8b9c0d12": {
+				"name":   "staging",
+				"status": "pool_required",
+				"tags":   []interface{}{},
+			},
+		},
+		requests: map[string]int{"GET /k8s/v1/regions/fr-par/clusters": 2},
+		get:      map[string]string{"id": "a7b8c9d0-e1f2-4a3b-9c4d-5e6f7a8b9c01", "region": "fr-par"},
+		notFound: map[string]string{"id": notFoundID, "region": "fr-par"},
+	},
+	{
+		table:   "scaleway_kubernetes_node",
+		fixture: "kubernetes",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"c9d0e1f2-a3b4-4c5d-9e6f-7a8b9c0d1e23": {
+				"name":         "scw-prod-default-0b5e8c6a",
+				"status":       "ready",
+				"cluster_id":   "a7b8c9d0-e1f2-4a3b-9c4d-5e6f7a8b9c01",
+				"pool_id":      "d0e1f2a3-b4c5-4d6e-8f7a-8b9c0d1e2f34",
+				"public_ip_v4": "51.15.220.12",
+				"conditions":   map[string]interface{}{"Ready": "True", "DiskPressure": "False"},
+				"tags":         nil,
+				"region":       "fr-par",
+			},
+		},
+		get:      map[string]string{"id": "c9d0e1f2-a3b4-4c5d-9e6f-7a8b9c0d1e23", "region": "fr-par"},
+		notFound: map[string]string{"id": notFoundID, "region": "fr-par"},
+	},
+	{
+		table:   "scaleway_kubernetes_pool",
+		fixture: "kubernetes",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"d0e1f2a3-b4c5-4d6e-8f7a-8b9c0d1e2f34": {
+				"name":        "default",
+				"status":      "ready",
+				"cluster_id":  "a7b8c9d0-e1f2-4a3b-9c4d-5e6f7a8b9c01",
+				"node_type":   "dev1_m",
+				"autoscaling": true,
+				"size":        1,
+				"max_size":    3,
+				"tags":        []interface{}{"pool=default"},
+				"region":      "fr-par",
+				"zone":        "fr-par-1",
+			},
+		},
+		get:      map[string]string{"id": "d0e1f2a3-b4c5-4d6e-8f7a-8b9c0d1e2f34", "region": "fr-par"},
+		notFound: map[string]string{"id": notFoundID, "region": "fr-par"},
+	},
+	{
+		table:   "scaleway_marketplace_image",
+		fixture: "marketplace",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"2a9c6f1e-5b3d-4e8a-9c7f-1d0e2b4a6c83": {
+				"name":        "Ubuntu 22.04 Jammy Jellyfish",
+				"label":       "ubuntu_jammy",
+				"categories":  []interface{}{"distribution"},
+				"valid_until": nil,
+				"local_images": []interface{}{
+					map[string]interface{}{
+						"id":                          "c3e5a7b9-1d2f-4a6c-8e0b-5d7f9a1c3e46",
+						"compatible_commercial_types": []interface{}{"DEV1-S", "DEV1-M", "GP1-XS", "PRO2-S"},
+						"arch":                        "x86_64",
+						"zone":                        "fr-par-1",
+						"label":                       "ubuntu_jammy",
+						"type":                        "instance_local",
+					},
+					map[string]interface{}{
+						"id":                          "d4f6b8c0-2e3a-4b7d-9f1c-6e8a0b2d4f57",
+						"compatible_commercial_types": []interface{}{"AMP2-C1", "AMP2-C2"},
+						"arch":                        "arm64",
+						"zone":                        "fr-par-1",
+						"label":                       "ubuntu_jammy",
+						"type":                        "instance_sbs",
+					},
+				},
+				"title": "Ubuntu 22.04 Jammy Jellyfish",
+			},
+			"7e1b3d5f-9a2c-4b6e-8d0f-3c5a7e9b1d24": {
+				"label":       "debian_buster",
+				"valid_until": "2024-06-30T00:00:00Z",
+			},
+		},
+		requests: map[string]int{"GET /marketplace/v2/images": 1},
+		get:      map[string]string{"id": "2a9c6f1e-5b3d-4e8a-9c7f-1d0e2b4a6c83"},
+		notFound: map[string]string{"id": notFoundID},
+	},
+	{
+		table:   "scaleway_marketplace_local_image",
+		fixture: "marketplace",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"c3e5a7b9-1d2f-4a6c-8e0b-5d7f9a1c3e46": {
+				"image_id":                    "2a9c6f1e-5b3d-4e8a-9c7f-1d0e2b4a6c83",
+				"image_name":                  "Ubuntu 22.04 Jammy Jellyfish",
+				"label":                       "ubuntu_jammy",
+				"arch":                        "x86_64",
+				"type":                        "instance_local",
+				"compatible_commercial_types": []interface{}{"DEV1-S", "DEV1-M", "GP1-XS", "PRO2-S"},
+				"zone":                        "fr-par-1",
+				"title":                       "Ubuntu 22.04 Jammy Jellyfish",
+			},
+			"e5a7c9d1-3f4b-4c8e-a02d-7f9b1c3e5a68": {
+				"image_name": "Debian Buster",
+				"label":      "debian_buster",
+			},
+		},
+		requests: map[string]int{"GET /marketplace/v2/images": 1, "GET /marketplace/v2/local-images": 2},
+	},
+	{
+		name:     "scaleway_marketplace_local_image/filters",
+		table:    "scaleway_marketplace_local_image",
+		fixture:  "marketplace",
+		quals:    map[string]string{"label": "ubuntu_jammy", "type": "instance_sbs"},
+		rows:     1,
+		want:     map[string]map[string]interface{}{"d4f6b8c0-2e3a-4b7d-9f1c-6e8a0b2d4f57": {"arch": "arm64"}},
+		requests: map[string]int{"GET /marketplace/v2/local-images": 1},
+	},
+	{
+		table:   "scaleway_object_bucket",
+		fixture: "object_bucket",
+		key:     "name",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"acme-assets": {
+				"creation_date":                        "2023-03-14T09:26:53Z",
+				"bucket_policy_is_public":              true,
+				"versioning_enabled":                   true,
+				"versioning_mfa_delete":                false,
+				"cors_rule":                            []interface{}{map[string]interface{}{"AllowedHeaders": nil, "AllowedMethods": []interface{}{"GET"}, "AllowedOrigins": []interface{}{"https://www.example.com"}, "ExposeHeaders": nil, "ID": nil, "MaxAgeSeconds": 3000}},
+				"lifecycle_rules":                      []interface{}{map[string]interface{}{"AbortIncompleteMultipartUpload": nil, "Expiration": map[string]interface{}{"Date": nil, "Days": 7, "ExpiredObjectDeleteMarker": nil}, "Filter": map[string]interface{}{"And": nil, "ObjectSizeGreaterThan": nil, "ObjectSizeLessThan": nil, "Prefix": "tmp/", "Tag": nil}, "ID": "expire-tmp", "NoncurrentVersionExpiration": nil, "NoncurrentVersionTransitions": nil, "Prefix": nil, "Status": "Enabled", "Transitions": nil}},
+				"policy":                               map[string]interface{}{"Version": "2023-04-17", "Id": "public-read", "Statement": []interface{}{map[string]interface{}{"Sid": "PublicRead", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "acme-assets/*"}}},
+				"tags":                                 []interface{}{"team"},
+				"object_lock_enabled":                  false,
+				"object_lock_mode":                     nil,
+				"server_side_encryption_configuration": map[string]interface{}{"Rules": []interface{}{map[string]interface{}{"ApplyServerSideEncryptionByDefault": map[string]interface{}{"KMSMasterKeyID": nil, "SSEAlgorithm": "AES256"}, "BucketKeyEnabled": nil}}},
+				"region":                               "fr-par",
+				"project":                              testProjectID,
+				"owner_display_name":                   testProjectID + ":" + testProjectID,
+			},
+			"acme-logs": {
+				"bucket_policy_is_public":              false,
+				"versioning_enabled":                   false,
+				"cors_rule":                            nil,
+				"lifecycle_rules":                      nil,
+				"policy":                               nil,
+				"tags":                                 nil,
+				"object_lock_enabled":                  true,
+				"object_lock_mode":                     "COMPLIANCE",
+				"object_lock_retention_days":           30,
+				"object_lock_retention_years":          nil,
+				"server_side_encryption_configuration": nil,
+			},
+		},
+		get:      map[string]string{"name": "acme-assets"},
+		notFound: map[string]string{"name": "acme-missing"},
+	},
+	{
+		table:   "scaleway_object_bucket_object",
+		fixture: "object_bucket_object",
+		quals:   map[string]string{"bucket": "acme-assets"},
+		key:     "key",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"backups/2023-06-01.tar.gz": {
+				"bucket":             "acme-assets",
+				"prefix":             nil,
+				"size":               5368709120,
+				"etag":               "\"9b2cf535f27731c974343645a3985328-12\"",
+				"storage_class":      "GLACIER",
+				"last_modified":      "2023-06-01T02:00:00Z",
+				"owner_id":           testProjectID + ":" + testProjectID,
+				"owner_display_name": testProjectID + ":" + testProjectID,
+				"region":             "fr-par",
+				"title":              "backups/2023-06-01.tar.gz",
+			},
+			"images/logo.png": {
+				"size":          20480,
+				"storage_class": "STANDARD",
+			},
+			"images/photo.jpg": {
+				"size":          1048576,
+				"storage_class": "ONEZONE_IA",
+			},
+		},
+		// One request per page of objects
+		requests: map[string]int{"GET /acme-assets": 2},
+	},
+	{
+		name:     "scaleway_object_bucket_object/prefix",
+		table:    "scaleway_object_bucket_object",
+		fixture:  "object_bucket_object",
+		quals:    map[string]string{"bucket": "acme-assets", "prefix": "images/"},
+		key:      "key",
+		rows:     2,
+		want:     map[string]map[string]interface{}{"images/photo.jpg": {"prefix": "images/"}},
+		requests: map[string]int{"GET /acme-assets": 1},
+	},
+	{
+		name:     "scaleway_object_bucket_object/key",
+		table:    "scaleway_object_bucket_object",
+		fixture:  "object_bucket_object",
+		quals:    map[string]string{"bucket": "acme-assets", "key": "images/logo.png"},
+		key:      "key",
+		rows:     1,
+		want:     map[string]map[string]interface{}{"images/logo.png": {"size": 20480}},
+		requests: map[string]int{"GET /acme-assets?prefix=images/logo.png&max-keys=1": 1},
+	},
+	{
+		name:     "scaleway_object_bucket_object/limit",
+		table:    "scaleway_object_bucket_object",
+		fixture:  "object_bucket_object",
+		quals:    map[string]string{"bucket": "acme-assets"},
+		limit:    1,
+		key:      "key",
+		rows:     1,
+		want:     map[string]map[string]interface{}{"backups/2023-06-01.tar.gz": {"size": 5368709120}},
+		requests: map[string]int{"GET /acme-assets": 1, "GET /acme-assets?max-keys=1": 1},
+	},
+	{
+		name:    "scaleway_object_bucket_object/missing_bucket",
+		table:   "scaleway_object_bucket_object",
+		fixture: "object_bucket_object",
+		quals:   map[string]string{"bucket": "acme-missing"},
+		rows:    0,
+	},
+	{
+		table:   "scaleway_object_bucket_object_version",
+		fixture: "object_bucket_object_version",
+		quals:   map[string]string{"bucket": "acme-assets"},
+		key:     "version_id",
+		rows:    5,
+		want: map[string]map[string]interface{}{
+			"1694510130000002": {
+				"key":                "images/logo.png",
+				"bucket":             "acme-assets",
+				"prefix":             nil,
+				"is_latest":          false,
+				"is_delete_marker":   false,
+				"size":               18432,
+				"etag":               "\"0cc175b9c0f1b6a831c399e269772661\"",
+				"storage_class":      "STANDARD",
+				"last_modified":      "2023-09-12T08:15:30Z",
+				"owner_id":           testProjectID + ":" + testProjectID,
+				"owner_display_name": testProjectID + ":" + testProjectID,
+				"region":             "fr-par",
+				"title":              "images/logo.png",
+			},
+			"1697012345000004": {
+				"key":              "images/photo.jpg",
+				"is_latest":        true,
+				"is_delete_marker": true,
+				"size":             nil,
+				"etag":             nil,
+				"storage_class":    nil,
+			},
+			"null": {
+				"key":       "readme.txt",
+				"is_latest": true,
+			},
+		},
+		// One request per page of versions
+		requests: map[string]int{"GET /acme-assets": 2},
+	},
+	{
+		name:    "scaleway_object_bucket_object_version/key",
+		table:   "scaleway_object_bucket_object_version",
+		fixture: "object_bucket_object_version",
+		quals:   map[string]string{"bucket": "acme-assets", "key": "images/photo.jpg"},
+		key:     "version_id",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"1696160525000003": {"is_latest": false, "size": 1048576},
+			"1697012345000004": {"is_delete_marker": true},
+		},
+		requests: map[string]int{"GET /acme-assets?prefix=images/photo.jpg": 1},
+	},
+	{
+		name:    "scaleway_object_bucket_object_version/missing_bucket",
+		table:   "scaleway_object_bucket_object_version",
+		fixture: "object_bucket_object_version",
+		quals:   map[string]string{"bucket": "acme-missing"},
+		rows:    0,
+	},
+	{
+		table:   "scaleway_object_bucket_policy_statement",
+		fixture: "object_bucket_policy_statement",
+		key:     "title",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"acme-assets PublicRead": {
+				"bucket":               "acme-assets",
+				"statement_index":      0,
+				"sid":                  "PublicRead",
+				"effect":               "Allow",
+				"principals":           []interface{}{"*"},
+				"actions":              []interface{}{"s3:GetObject"},
+				"resources":            []interface{}{"acme-assets/*"},
+				"conditions":           nil,
+				"allows_anonymous":     true,
+				"allows_cross_project": false,
+				"grants_write":         false,
+				"policy_id":            "acme-assets-policy",
+				"policy_version":       "2023-04-17",
+				"region":               "fr-par",
+				"project":              testProjectID,
+			},
+			"acme-assets PartnerUpload": {
+				"principals":           []interface{}{"project_id:4c7b2e1a-8d3f-4a6b-9e5c-0f1a2b3c4d5e", "application_id:1f2e3d4c-5b6a-4978-8a9b-0c1d2e3f4a5b"},
+				"actions":              []interface{}{"s3:PutObject", "s3:GetObject"},
+				"allows_anonymous":     false,
+				"allows_cross_project": true,
+				"grants_write":         true,
+			},
+			// The denied statements grant nothing
+			"acme-assets statement 2": {
+				"sid":                  nil,
+				"effect":               "Deny",
+				"principals":           []interface{}{"project_id:" + testProjectID},
+				"resources":            []interface{}{"acme-assets", "acme-assets/*"},
+				"conditions":           map[string]interface{}{"NotIpAddress": map[string]interface{}{"aws:SourceIp": "198.51.100.0/24"}},
+				"allows_cross_project": false,
+				"grants_write":         false,
+			},
+		},
+		requests: map[string]int{"GET /acme-assets": 1, "GET /acme-logs": 1},
+	},
+	{
+		name:     "scaleway_object_bucket_policy_statement/bucket",
+		table:    "scaleway_object_bucket_policy_statement",
+		fixture:  "object_bucket_policy_statement",
+		quals:    map[string]string{"bucket": "acme-assets"},
+		key:      "title",
+		rows:     3,
+		requests: map[string]int{"GET /acme-assets": 1, "GET /acme-logs": 0},
+	},
+	{
+		table:   "scaleway_rdb_database",
+		fixture: "rdb",
+		key:     "name",
+		rows:    2,
+		want: map[string]map[string]interface{}{
+			"orders": {
+				"instance_id":  "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a56",
+				"owner":        "orders_app",
+				"managed":      true,
+				"size":         10485760,
+				"region":       "fr-par",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+			},
+		},
+	},
+	{
+		table:   "scaleway_rdb_instance",
+		fixture: "rdb",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a56": {
+				"name":            "orders-db",
+				"status":          "ready",
+				"engine":          "PostgreSQL-15",
+				"is_has_cluster":  true,
+				"node_type":       "db-dev-s",
+				"backup_schedule": map[string]interface{}{"frequency": 24, "retention": 7, "disabled": false, "next_run_at": "2023-10-06T02:00:00Z"},
+				"volume":          map[string]interface{}{"type": "lssd", "size": 5000000000, "class": "unknown_storage_class"},
+				"tags":            []interface{}{"orders"},
+				"region":          "fr-par",
+				"project":         testProjectID,
+				"organization":    testOrganizationID,
+			},
+		},
+		get:      map[string]string{"id": "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a56", "region": "fr-par"},
+		notFound: map[string]string{"id": notFoundID, "region": "fr-par"},
+	},
+	{
+		table:   "scaleway_registry_image",
+		fixture: "registry",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"b4c5d6e7-f8a9-4b0c-9d1e-2f3a4b5c6d78": {
+				"name":         "api",
+				"namespace_id": "a3b4c5d6-e7f8-4a9b-8c0d-1e2f3a4b5c67",
+				"status":       "ready",
+				"visibility":   "inherit",
+				"size":         734003200,
+				"tags":         []interface{}{"latest", "v1.4.0"},
+			},
+		},
+		get:      map[string]string{"id": "b4c5d6e7-f8a9-4b0c-9d1e-2f3a4b5c6d78"},
+		notFound: map[string]string{"id": notFoundID},
+	},
+	{
+		table:   "scaleway_registry_namespace",
+		fixture: "registry",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"a3b4c5d6-e7f8-4a9b-8c0d-1e2f3a4b5c67": {
+				"name":         "acme",
+				"status":       "ready",
+				"endpoint":     "rg.fr-par.scw.cloud/acme",
+				"is_public":    false,
+				"size":         734003200,
+				"image_count":  1,
+				"region":       "fr-par",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+			},
+		},
+		get:      map[string]string{"id": "a3b4c5d6-e7f8-4a9b-8c0d-1e2f3a4b5c67", "region": "fr-par"},
+		notFound: map[string]string{"id": notFoundID, "region": "fr-par"},
+	},
+	{
+		table:   "scaleway_server_event",
+		fixture: "server_event",
+		key:     "title",
+		rows:    3,
+		want: map[string]map[string]interface{}{
+			"web-1 maintenance": {
+				"server_type": "instance",
+				"server_id":   "0b5e8c6a-6f7c-4e3a-9b2d-1a4c8e7f2d10",
+				"server_name": "web-1",
+				"id":          nil,
+				"action":      nil,
+				"reason":      "Hypervisor security update",
+				"start_time":  "2023-11-08T01:00:00Z",
+				"zone":        "fr-par-1",
+				"project":     testProjectID,
+			},
+			"db-metal-1 reboot": {
+				"id":           "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e50",
+				"server_type":  "baremetal",
+				"server_id":    "e4a1b2c3-d4e5-4f60-9a7b-8c9d0e1f2a30",
+				"server_name":  "db-metal-1",
+				"action":       "reboot",
+				"reason":       nil,
+				"start_time":   "2023-10-20T22:15:00Z",
+				"updated_at":   "2023-10-20T22:17:30Z",
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+			},
+		},
+	},
+	{
+		name:     "scaleway_server_event/instance_server",
+		table:    "scaleway_server_event",
+		fixture:  "server_event",
+		quals:    map[string]string{"server_type": "instance", "server_id": "8f1a2b3c-4d5e-4f60-8a7b-9c0d1e2f3a40"},
+		rows:     0,
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/servers": 1, "GET /baremetal/v1/zones/fr-par-1/servers": 0},
+	},
+	{
+		name:     "scaleway_server_event/baremetal_server",
+		table:    "scaleway_server_event",
+		fixture:  "server_event",
+		quals:    map[string]string{"server_type": "baremetal", "server_id": "e4a1b2c3-d4e5-4f60-9a7b-8c9d0e1f2a30"},
+		rows:     2,
+		requests: map[string]int{"GET /instance/v1/zones/fr-par-1/servers": 0, "GET /baremetal/v1/zones/fr-par-1/servers/f5b2c3d4-e5f6-4a71-8b8c-9d0e1f2a3b41/events": 0},
+	},
+	{
+		table:   "scaleway_vpc_private_network",
+		fixture: "vpc_private_network",
+		rows:    1,
+		want: map[string]map[string]interface{}{
+			"c5d6e7f8-a9b0-4c1d-8e2f-3a4b5c6d7e89": {
+				"name":         "backend",
+				"tags":         []interface{}{"backend"},
+				"created_at":   "2023-04-01T10:00:00Z",
+				"zone":         "fr-par-1",
+				"project":      testProjectID,
+				"organization": testOrganizationID,
+			},
+		},
+		get:      map[string]string{"id": "c5d6e7f8-a9b0-4c1d-8e2f-3a4b5c6d7e89", "zone": "fr-par-1"},
+		notFound: map[string]string{"id": notFoundID, "zone": "fr-par-1"},
+	},
+}
+
+func TestTables(t *testing.T) {
+	for _, tt := range tableTests {
+		tt := tt
+		name := tt.name
+		if name == "" {
+			name = tt.table
+		}
+		t.Run(name, func(t *testing.T) {
+			key := tt.key
+			if key == "" {
+				key = "id"
+			}
+
+			server := newFixtureServer(t, tt.fixture)
+			connection := newTestConnection(t, testConnectionConfig(server, tt.config))
+
+			rows, err := executeQuery(t, testQuery{Connection: connection, Table: tt.table, Quals: tt.quals, Limit: tt.limit})
+			if err != nil {
+				t.Fatalf("list query failed: %v", err)
+			}
+			if len(rows) != tt.rows {
+				t.Fatalf("list query returned %d rows, want %d: %v", len(rows), tt.rows, rows)
+			}
+			listed := map[string]map[string]interface{}{}
+			for _, row := range rows {
+				listed[rowValue(row, key)] = row
+			}
+			for id, want := range tt.want {
+				row, ok := listed[id]
+				if !ok {
+					t.Errorf("list query did not return %s %s", key, id)
+					continue
+				}
+				assertColumns(t, "list", row, want)
+			}
+			for path, want := range tt.requests {
+				method, path, _ := strings.Cut(path, " ")
+				if got := server.requestCount(method, path); got != want {
+					t.Errorf("list query sent %d requests to %s %s, want %d", got, method, path, want)
+				}
+			}
+
+			if tt.get != nil {
+				rows, err := executeQuery(t, testQuery{Connection: connection, Table: tt.table, Quals: tt.get})
+				if err != nil {
+					t.Fatalf("get query failed: %v", err)
+				}
+				if len(rows) != 1 {
+					t.Fatalf("get query returned %d rows, want 1: %v", len(rows), rows)
+				}
+				if got, want := rowValue(rows[0], key), tt.get[key]; got != want {
+					t.Errorf("get query returned %s %s, want %s", key, got, want)
+				}
+			}
+
+			if tt.notFound != nil {
+				rows, err := executeQuery(t, testQuery{Connection: connection, Table: tt.table, Quals: tt.notFound})
+				if err != nil {
+					t.Fatalf("get query of a missing resource failed: %v", err)
+				}
+				if len(rows) != 0 {
+					t.Errorf("get query of a missing resource returned %d rows, want 0: %v", len(rows), rows)
+				}
+			}
+		})
+	}
+}
+
+// TestOrganizationResolutionError ensures the organization tables fail with the error resolving
+// the organization of the API key, not with a missing organization_id
+func TestOrganizationResolutionError(t *testing.T) {
+	server := newFixtureServer(t, "organization_denied")
+	connection := newTestConnection(t, fmt.Sprintf(`
+access_key  = "SCWTESTTESTTESTTESTT"
+secret_key  = "5b0d8f3e-1c2a-4e6b-9f7d-3a8c2e1b4d60"
+api_url     = "%s"
+max_retries = 0
+`, server.URL))
+
+	_, err := executeQuery(t, testQuery{Connection: connection, Table: "scaleway_iam_user"})
+	if err == nil || !strings.Contains(err.Error(), "unable to resolve the organization of the API key") || !strings.Contains(err.Error(), "insufficient permissions") {
+		t.Errorf("query error = %v, want the organization resolution error", err)
+	}
+}
+
+// TestInvalidConnectionConfig ensures a connection with invalid regions or zones fails to load
+func TestInvalidConnectionConfig(t *testing.T) {
+	for name, tt := range map[string]struct {
+		config string
+		want   string
+	}{
+		"regions":         {config: `regions = ["fr-par", "fr-pa"]`, want: "invalid regions: fr-pa."},
+		"zones":           {config: `zones = ["fr-par-9"]`, want: "invalid zones: fr-par-9."},
+		"max_retries":     {config: `max_retries = -1`, want: "invalid max_retries: -1."},
+		"min_retry_delay": {config: `min_retry_delay = -100`, want: "invalid min_retry_delay: -100."},
+		"rate_limits": {
+			config: `rate_limits = { scaleway_s3 = 20, scaleway_ipam = 10, scaleway_k8s = 0 }`,
+			want:   "invalid rate_limits: scaleway_ipam, scaleway_k8s.",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, failure := loadTestConnection(t, tt.config)
+			if !strings.Contains(failure, tt.want) {
+				t.Errorf("connection failure = %q, want it to contain %q", failure, tt.want)
+			}
+		})
+	}
+}
+
+// TestObjectBucketGet ensures a bucket queried by name is only hydrated, not the other buckets
+func TestObjectBucketGet(t *testing.T) {
+	server := newFixtureServer(t, "object_bucket")
+	connection := newTestConnection(t, testConnectionConfig(server, ""))
+
+	rows, err := executeQuery(t, testQuery{Connection: connection, Table: "scaleway_object_bucket", Quals: map[string]string{"name": "acme-assets"}})
+	if err != nil {
+		t.Fatalf("get query failed: %v", err)
+	}
+	if len(rows) != 1 || rows[0]["versioning_enabled"] != true {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+	if got := server.requestCount("HEAD", "/acme-assets"); got != 1 {
+		t.Errorf("get query sent %d HeadBucket requests, want 1", got)
+	}
+	if got := server.requestCount("GET", "/acme-logs"); got != 0 {
+		t.Errorf("get query sent %d requests for another bucket, want 0", got)
+	}
+}
+
+// TestObjectBucketNameIn ensures the buckets of a name in list are the only ones hydrated
+func TestObjectBucketNameIn(t *testing.T) {
+	server := newFixtureServer(t, "object_bucket")
+	connection := newTestConnection(t, testConnectionConfig(server, ""))
+
+	rows, err := executeQuery(t, testQuery{Connection: connection, Table: "scaleway_object_bucket", Columns: []string{"name", "versioning_enabled"}, Quals: map[string]string{"name in": "acme-assets,acme-missing"}})
+	if err != nil {
+		t.Fatalf("in query failed: %v", err)
+	}
+	if len(rows) != 1 || rows[0]["name"] != "acme-assets" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+	if got := server.requestCount("GET", "/acme-logs"); got != 0 {
+		t.Errorf("in query sent %d requests for another bucket, want 0", got)
+	}
+}
+
+// TestObjectBucketGetMultiRegion ensures a bucket queried by name is only got in its region, the
+// buckets without region header nor location constraint being in fr-par, whatever the regions order
+func TestObjectBucketGetMultiRegion(t *testing.T) {
+	server := newFixtureServer(t, "object_bucket_multi_region")
+	connection := newTestConnection(t, fmt.Sprintf(`
+access_key      = "SCWTESTTESTTESTTESTT"
+secret_key      = "5b0d8f3e-1c2a-4e6b-9f7d-3a8c2e1b4d60"
+organization_id = "%s"
+regions         = ["nl-ams", "fr-par"]
+s3_endpoint     = "%s/{region}"
+max_retries     = 0
+`, testOrganizationID, server.URL))
+
+	for name, region := range map[string]string{
+		"acme-assets":  "fr-par",
+		"acme-legacy":  "fr-par",
+		"acme-archive": "nl-ams",
+	} {
+		rows, err := executeQuery(t, testQuery{Connection: connection, Table: "scaleway_object_bucket", Columns: []string{"name", "region"}, Quals: map[string]string{"name": name}})
+		if err != nil {
+			t.Fatalf("get query failed: %v", err)
+		}
+		if len(rows) != 1 || rows[0]["region"] != region {
+			t.Errorf("bucket %s: got rows %v, want a single row in %s", name, rows, region)
+		}
+	}
+
+	// The buckets are only listed in their region
+	for region, want := range map[string]int{"fr-par": 2, "nl-ams": 1} {
+		if got := server.requestCount("GET", "/"+region+"/"); got != want {
+			t.Errorf("get queries sent %d ListBuckets requests in %s, want %d", got, region, want)
+		}
+	}
+}
+
+// TestTablesCovered ensures every table of the plugin is tested against fixtures
+func TestTablesCovered(t *testing.T) {
+	tested := map[string]bool{}
+	for _, tt := range tableTests {
+		tested[tt.table] = true
+	}
+
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	for name := range tableDefinitions(ctx) {
+		if !tested[name] {
+			t.Errorf("table %s has no test", name)
+		}
+	}
+}
+
+// TestRateLimiters ensures every table is limited by the limiter of each product it queries
+func TestRateLimiters(t *testing.T) {
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	definitions := Plugin(ctx).RateLimiters
+	for _, definition := range definitions {
+		if err := definition.Initialise(); err != nil {
+			t.Fatalf("invalid limiter %s: %v", definition.Name, err)
+		}
+	}
+
+	// The tables querying several products, or limited by rate and concurrency, by limiter name
+	want := map[string][]string{
+		"scaleway_server_event":                   {"scaleway_baremetal", "scaleway_instance"},
+		"scaleway_object_bucket":                  {"scaleway_s3", "scaleway_s3_concurrency"},
+		"scaleway_object_bucket_object":           {"scaleway_s3", "scaleway_s3_concurrency"},
+		"scaleway_object_bucket_object_version":   {"scaleway_s3", "scaleway_s3_concurrency"},
+		"scaleway_object_bucket_policy_statement": {"scaleway_s3", "scaleway_s3_concurrency"},
+	}
+
+	for name, table := range tableDefinitions(ctx) {
+		scopeValues := map[string]string{"table": name}
+		for key, value := range table.Tags {
+			scopeValues[key] = value
+		}
+
+		var limiters []string
+		for _, definition := range definitions {
+			if definition.SatisfiesFilters(scopeValues) {
+				limiters = append(limiters, definition.Name)
+			}
+		}
+		sort.Strings(limiters)
+
+		if expected, ok := want[name]; ok {
+			if strings.Join(limiters, ",") != strings.Join(expected, ",") {
+				t.Errorf("table %s is limited by %v, want %v", name, limiters, expected)
+			}
+		} else if len(limiters) != 1 {
+			t.Errorf("table %s is limited by %v, want a single limiter", name, limiters)
+		}
+	}
+}
+
+// TestLogLabels ensures the label of each error log of a table file is
+// "<table>.<function>", so errors can be traced back to their origin
+func TestLogLabels(t *testing.T) {
+	files, err := filepath.Glob("table_*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tableName := strings.TrimSuffix(strings.TrimPrefix(file, "table_"), ".go")
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			want := tableName + "." + fn.Name.Name
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || !isErrorLogCall(call) || len(call.Args) == 0 {
+					return true
+				}
+				lit, ok := call.Args[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return true
+				}
+				if label, _ := strconv.Unquote(lit.Value); label != want {
+					t.Errorf("%s: log label %q, want %q", fset.Position(lit.Pos()), label, want)
+				}
+				return true
+			})
+		}
+	}
+}
+
+// isErrorLogCall returns true for calls like plugin.Logger(ctx).Error(...)
+func isErrorLogCall(call *ast.CallExpr) bool {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "Error" {
+		return false
+	}
+	inner, ok := selector.X.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fun, ok := inner.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := fun.X.(*ast.Ident)
+	return ok && pkg.Name == "plugin" && fun.Sel.Name == "Logger"
+}
+
+func rowValue(row map[string]interface{}, column string) string {
+	value, _ := row[column].(string)
+	return value
+}
+
+// assertColumns compares the columns of a row to the expected values, as JSON
+// so numbers, timestamps and nested values compare regardless of their Go type
+func assertColumns(t *testing.T, query string, row map[string]interface{}, want map[string]interface{}) {
+	t.Helper()
+	for column, wantValue := range want {
+		got, err := json.Marshal(row[column])
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := json.Marshal(wantValue)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !jsonEqual(got, expected) {
+			t.Errorf("%s query: column %s = %s, want %s", query, column, got, expected)
+		}
+	}
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+	return string(ca) == string(cb)
+}
@@ -2,9 +2,14 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
@@ -27,3 +32,62 @@ func flattenObjectBucketTags(tagsSet []*s3.Tag) []string {
 
 	return tags
 }
+
+// getQualsEqualsValues :: returns the values of the "=" quals on a string column, including the values of an "in" list
+func getQualsEqualsValues(d *plugin.QueryData, column string) []string {
+	var values []string
+	if d.Quals[column] == nil {
+		return values
+	}
+	for _, q := range d.Quals[column].Quals {
+		if q.Operator != quals.QualOperatorEqual {
+			continue
+		}
+		if listValue := q.Value.GetListValue(); listValue != nil {
+			for _, value := range listValue.Values {
+				values = append(values, value.GetStringValue())
+			}
+		} else {
+			values = append(values, q.Value.GetStringValue())
+		}
+	}
+	return values
+}
+
+// getQualsExistsValues :: returns the values of the "<column> ? '<value>'" quals on a JSON array column
+func getQualsExistsValues(d *plugin.QueryData, column string) []string {
+	var values []string
+	if d.Quals[column] == nil {
+		return values
+	}
+	for _, q := range d.Quals[column].Quals {
+		if q.Operator == quals.QualOperatorJsonbExistsOne {
+			values = append(values, q.Value.GetStringValue())
+		}
+	}
+	return values
+}
+
+// memoizeByZone :: caches the result of a memoized function per zone, the zone
+// being passed as the item of the hydrate data. The result is only kept for a
+// minute, long enough to be shared by the rows of a query without going stale.
+func memoizeByZone(name string) plugin.MemoizeOption {
+	return func(config *plugin.MemoizeConfiguration) {
+		config.GetCacheKeyFunc = func(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+			return fmt.Sprintf("%s-%s", name, h.Item.(scw.Zone)), nil
+		}
+		config.Ttl = time.Minute
+	}
+}
+
+// memoizeByName :: caches the result of a memoized function under a single key,
+// for the hydrate functions whose result doesn't depend on the row or the zone.
+// The result is only kept for a minute, like memoizeByZone.
+func memoizeByName(name string) plugin.MemoizeOption {
+	return func(config *plugin.MemoizeConfiguration) {
+		config.GetCacheKeyFunc = func(_ context.Context, _ *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+			return name, nil
+		}
+		config.Ttl = time.Minute
+	}
+}